@@ -98,6 +98,9 @@ type PageNavigator interface {
 	ClearStackAndDisplay(page Page)
 	// CloseAllPages dismisses all pages in the stack.
 	CloseAllPages()
+	// PageIDs returns the IDs of the pages in the stack, the bottom page
+	// first. The IDs of the subpages of a page follow its own ID.
+	PageIDs() []string
 }
 
 // WindowNavigator defines methods for page navigation, displaying modals and
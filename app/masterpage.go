@@ -88,3 +88,9 @@ func (masterPage *MasterPage) CloseAllPages() {
 	masterPage.subPages.Reset()
 	masterPage.ParentWindow().Reload()
 }
+
+// PageIDs returns the IDs of the pages in the stack, the bottom page first.
+// Part of the PageNavigator interface.
+func (masterPage *MasterPage) PageIDs() []string {
+	return masterPage.subPages.IDs()
+}
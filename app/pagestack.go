@@ -154,6 +154,26 @@ func (pageStack *PageStack) Reset(newPages ...Page) {
 	}
 }
 
+// IDs returns the IDs of the pages in the stack, the bottom page first. The
+// IDs of the subpages of a page that displays subpages follow its own ID,
+// prefixed with it.
+func (pageStack *PageStack) IDs() []string {
+	pageStack.mtx.Lock()
+	pages := append([]Page(nil), pageStack.pages...)
+	pageStack.mtx.Unlock()
+
+	ids := make([]string, 0, len(pages))
+	for _, page := range pages {
+		ids = append(ids, page.ID())
+		if navigator, ok := page.(PageNavigator); ok {
+			for _, id := range navigator.PageIDs() {
+				ids = append(ids, page.ID()+"/"+id)
+			}
+		}
+	}
+	return ids
+}
+
 func (pageStack *PageStack) pagesAfter(stopPageID *string) (pages []Page) {
 	pageStack.mtx.Lock()
 	defer pageStack.mtx.Unlock()
@@ -92,6 +92,12 @@ func (window *SimpleWindowNavigator) CloseAllPages() {
 	window.Reload()
 }
 
+// PageIDs returns the IDs of the pages in the stack, the bottom page first.
+// Part of the PageNavigator interface.
+func (window *SimpleWindowNavigator) PageIDs() []string {
+	return window.subPages.IDs()
+}
+
 // ShowModal displays a modal over the current page. Any previously displayed
 // modal will be hidden by this new modal. NOTE: Allows displaying multiple
 // instances of the same modal.
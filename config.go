@@ -40,6 +40,15 @@ type config struct {
 	Quiet            bool   `short:"q" long:"quiet" description:"Easy way to set debuglevel to error"`
 	SpendUnconfirmed bool   `long:"spendunconfirmed" description:"Allow the multiwallet to use transactions that have not been confirmed"`
 	Profile          int    `long:"profile" description:"Runs local web server for profiling"`
+	ProfileName      string `long:"profile-name" description:"Name of the app profile to use. The profile is created with the network option if it does not exist"`
+	Proxy            string `long:"proxy" description:"Send the app's HTTP requests through the SOCKS5 proxy at this address, eg. 127.0.0.1:9050. Wallet sync, politeia, VSP and DEX connections do not use the proxy, use --toronly to turn them off. Overrides the proxy in the app settings"`
+	ProxyUser        string `long:"proxyuser" description:"Username for the proxy"`
+	ProxyPass        string `long:"proxypass" default-mask:"-" description:"Password for the proxy"`
+	TorIsolation     bool   `long:"torisolation" description:"Use a separate Tor circuit for each connection through the proxy"`
+	TorOnly          bool   `long:"toronly" description:"Refuse connections that cannot go through the proxy"`
+
+	// args are the arguments that are not options, such as payment URIs.
+	args []string
 }
 
 var defaultConfig = config{
@@ -203,7 +212,7 @@ func loadConfig() (*config, error) {
 	}
 
 	// Parse command line options again to ensure they take precedence.
-	_, err = parser.Parse()
+	cfg.args, err = parser.Parse()
 	if err != nil {
 		if e, ok := err.(*flags.Error); !ok || e.Type != flags.ErrHelp {
 			parser.WriteHelp(os.Stderr)
@@ -3,16 +3,14 @@ package listeners
 import "github.com/planetdecred/godcr/wallet"
 
 // AccountMixerNotificationListener satisfies dcrlibwallet
-// AccountMixerNotificationListener interface. Consumers interested in mixer
-// notification instantiates this type.
+// AccountMixerNotificationListener interface. It publishes mixer
+// notifications on the bus under MixerTopic.
 type AccountMixerNotificationListener struct {
-	MixerChan chan wallet.AccountMixer
+	bus *Bus
 }
 
-func NewAccountMixerNotificationListener() *AccountMixerNotificationListener {
-	return &AccountMixerNotificationListener{
-		MixerChan: make(chan wallet.AccountMixer, 4),
-	}
+func NewAccountMixerNotificationListener(bus *Bus) *AccountMixerNotificationListener {
+	return &AccountMixerNotificationListener{bus: bus}
 }
 
 // OnAccountMixerStarted is a callback func called when the account mixer is
@@ -33,5 +31,5 @@ func (am *AccountMixerNotificationListener) OnAccountMixerEnded(walletID int) {
 }
 
 func (am *AccountMixerNotificationListener) UpdateNotification(signal wallet.AccountMixer) {
-	am.MixerChan <- signal
+	am.bus.Publish(signal)
 }
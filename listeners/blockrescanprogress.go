@@ -6,15 +6,14 @@ import (
 )
 
 // BlocksRescanProgressListener satisfies dcrlibwallet
-// BlocksRescanProgressListener interface.
+// BlocksRescanProgressListener interface. It publishes rescan updates on the
+// bus under RescanTopic.
 type BlocksRescanProgressListener struct {
-	BlockRescanChan chan wallet.RescanUpdate
+	bus *Bus
 }
 
-func NewBlocksRescanProgressListener() *BlocksRescanProgressListener {
-	return &BlocksRescanProgressListener{
-		BlockRescanChan: make(chan wallet.RescanUpdate, 4),
-	}
+func NewBlocksRescanProgressListener(bus *Bus) *BlocksRescanProgressListener {
+	return &BlocksRescanProgressListener{bus: bus}
 }
 
 // OnBlocksRescanStarted is a callback func called when block rescan is started.
@@ -43,5 +42,5 @@ func (br *BlocksRescanProgressListener) OnBlocksRescanEnded(walletID int, err er
 }
 
 func (br *BlocksRescanProgressListener) UpdateNotification(signal wallet.RescanUpdate) {
-	br.BlockRescanChan <- signal
+	br.bus.Publish(signal)
 }
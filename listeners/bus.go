@@ -0,0 +1,270 @@
+package listeners
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// busListenerID identifies the listeners that publish dcrlibwallet
+// notifications on the bus.
+const busListenerID = "godcr_event_bus"
+
+// DefaultQueueSize is the number of events a subscription holds while its
+// subscriber is busy.
+const DefaultQueueSize = 32
+
+// Topic is a kind of event published on the bus. Each topic has a single
+// event type.
+type Topic int
+
+const (
+	SyncTopic       Topic = iota // wallet.SyncStatusUpdate
+	TxAndBlockTopic              // TxNotification
+	ProposalTopic                // wallet.Proposal
+	MixerTopic                   // wallet.AccountMixer
+	RescanTopic                  // wallet.RescanUpdate
+)
+
+// Policy decides which events a subscription keeps when its queue is full.
+type Policy int
+
+const (
+	// DropOldest discards the oldest queued event to make room for a new
+	// one.
+	DropOldest Policy = iota
+
+	// DropNewest discards new events until the subscriber catches up.
+	DropNewest
+
+	// Coalesce replaces a queued progress event with a newer one of the
+	// same kind. Other events are queued as with DropOldest.
+	Coalesce
+)
+
+// Bus delivers wallet notifications to any number of subscribers without
+// blocking the publisher. Each subscriber has its own bounded queue, so a
+// slow page only misses its own events.
+type Bus struct {
+	mu   sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subs: make(map[*Subscription]struct{}),
+	}
+}
+
+// Attach publishes the notifications of the multiwallet loaded by wal on the
+// bus. It is called once for each loaded multiwallet.
+func (bus *Bus) Attach(wal *wallet.Wallet) error {
+	mw := wal.GetMultiWallet()
+	err := mw.AddSyncProgressListener(NewSyncProgress(bus), busListenerID)
+	if err != nil {
+		return fmt.Errorf("error adding sync progress listener: %v", err)
+	}
+
+	err = mw.AddTxAndBlockNotificationListener(NewTxAndBlockNotificationListener(bus), false, busListenerID)
+	if err != nil {
+		return fmt.Errorf("error adding tx and block notification listener: %v", err)
+	}
+
+	err = mw.Politeia.AddNotificationListener(NewProposalNotificationListener(bus), busListenerID)
+	if err != nil {
+		return fmt.Errorf("error adding politeia notification listener: %v", err)
+	}
+
+	err = mw.AddAccountMixerNotificationListener(NewAccountMixerNotificationListener(bus), busListenerID)
+	if err != nil {
+		return fmt.Errorf("error adding account mixer notification listener: %v", err)
+	}
+
+	// The wallet queues rescans and passes their notifications on.
+	wal.SetRescanProgressListener(NewBlocksRescanProgressListener(bus))
+	return nil
+}
+
+// Publish queues event for the subscribers of its topic. It never blocks.
+func (bus *Bus) Publish(event interface{}) {
+	topic, ok := topicOf(event)
+	if !ok {
+		log.Errorf("Unknown event type %T published", event)
+		return
+	}
+
+	bus.mu.RLock()
+	defer bus.mu.RUnlock()
+	for sub := range bus.subs {
+		if sub.topics[topic] {
+			sub.push(event)
+		}
+	}
+}
+
+// Subscribe returns a subscription to the events of topics. The subscription
+// ends and its Events channel is closed when ctx is canceled, so pages
+// subscribe with the context created in OnNavigatedTo and canceled in
+// OnNavigatedFrom.
+func (bus *Bus) Subscribe(ctx context.Context, policy Policy, topics ...Topic) *Subscription {
+	sub := &Subscription{
+		topics:    make(map[Topic]bool, len(topics)),
+		policy:    policy,
+		queueSize: DefaultQueueSize,
+		notify:    make(chan struct{}, 1),
+		events:    make(chan interface{}),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = true
+	}
+
+	bus.mu.Lock()
+	bus.subs[sub] = struct{}{}
+	bus.mu.Unlock()
+
+	go func() {
+		sub.deliver(ctx)
+
+		bus.mu.Lock()
+		delete(bus.subs, sub)
+		bus.mu.Unlock()
+	}()
+
+	return sub
+}
+
+// Subscription is a subscriber's queue of events.
+type Subscription struct {
+	topics    map[Topic]bool
+	policy    Policy
+	queueSize int
+
+	mu      sync.Mutex
+	queue   []interface{}
+	dropped int
+
+	// notify is signaled when an event is queued.
+	notify chan struct{}
+	events chan interface{}
+}
+
+// Events returns the channel that the subscribed events are delivered on, in
+// the order they were published. The event types are listed with the topics.
+func (sub *Subscription) Events() <-chan interface{} {
+	return sub.events
+}
+
+// Dropped returns the number of events discarded because the queue was full.
+func (sub *Subscription) Dropped() int {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	return sub.dropped
+}
+
+func (sub *Subscription) push(event interface{}) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	queued := false
+	if key, ok := coalesceKey(event); ok && sub.policy == Coalesce {
+		for i, e := range sub.queue {
+			if k, ok := coalesceKey(e); ok && k == key {
+				sub.queue[i] = event
+				queued = true
+				break
+			}
+		}
+	}
+
+	if !queued {
+		if len(sub.queue) >= sub.queueSize {
+			sub.dropped++
+			if sub.policy == DropNewest {
+				return
+			}
+			sub.queue = sub.queue[1:]
+		}
+		sub.queue = append(sub.queue, event)
+	}
+
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (sub *Subscription) pop() (interface{}, bool) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if len(sub.queue) == 0 {
+		return nil, false
+	}
+	event := sub.queue[0]
+	sub.queue = sub.queue[1:]
+	return event, true
+}
+
+// deliver sends the queued events on the events channel until ctx is
+// canceled.
+func (sub *Subscription) deliver(ctx context.Context) {
+	defer close(sub.events)
+	for {
+		event, ok := sub.pop()
+		if !ok {
+			select {
+			case <-sub.notify:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case sub.events <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func topicOf(event interface{}) (Topic, bool) {
+	switch event.(type) {
+	case wallet.SyncStatusUpdate:
+		return SyncTopic, true
+	case TxNotification:
+		return TxAndBlockTopic, true
+	case wallet.Proposal:
+		return ProposalTopic, true
+	case wallet.AccountMixer:
+		return MixerTopic, true
+	case wallet.RescanUpdate:
+		return RescanTopic, true
+	}
+	return 0, false
+}
+
+// progressKey identifies progress events that a newer event of the same kind
+// makes obsolete.
+type progressKey struct {
+	topic    Topic
+	stage    int
+	walletID int
+}
+
+func coalesceKey(event interface{}) (progressKey, bool) {
+	switch e := event.(type) {
+	case wallet.SyncStatusUpdate:
+		switch e.Stage {
+		case wallet.PeersConnected, wallet.CfiltersFetchProgress, wallet.HeadersFetchProgress,
+			wallet.AddressDiscoveryProgress, wallet.HeadersRescanProgress:
+			return progressKey{topic: SyncTopic, stage: int(e.Stage)}, true
+		}
+	case wallet.RescanUpdate:
+		if e.Stage == wallet.RescanProgress {
+			return progressKey{topic: RescanTopic, stage: int(e.Stage), walletID: e.WalletID}, true
+		}
+	}
+	return progressKey{}, false
+}
@@ -0,0 +1,112 @@
+package listeners
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/planetdecred/godcr/wallet"
+)
+
+func receive(t *testing.T, sub *Subscription) interface{} {
+	t.Helper()
+	select {
+	case event := <-sub.Events():
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event delivered")
+	}
+	return nil
+}
+
+func TestBusTopics(t *testing.T) {
+	bus := NewBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	syncSub := bus.Subscribe(ctx, DropOldest, SyncTopic)
+	txSub := bus.Subscribe(ctx, DropOldest, SyncTopic, TxAndBlockTopic)
+
+	bus.Publish(TxNotification{Type: BlockAttached, BlockHeight: 10})
+	bus.Publish(wallet.SyncStatusUpdate{Stage: wallet.SyncCompleted})
+
+	if event, ok := receive(t, syncSub).(wallet.SyncStatusUpdate); !ok || event.Stage != wallet.SyncCompleted {
+		t.Fatalf("sync subscriber got %v", event)
+	}
+	if event, ok := receive(t, txSub).(TxNotification); !ok || event.BlockHeight != 10 {
+		t.Fatalf("first event of tx subscriber is %v", event)
+	}
+	if _, ok := receive(t, txSub).(wallet.SyncStatusUpdate); !ok {
+		t.Fatal("second event of tx subscriber is not a sync update")
+	}
+}
+
+func TestSubscriptionPolicies(t *testing.T) {
+	progress := func(stage wallet.SyncNotificationType, peers int32) wallet.SyncStatusUpdate {
+		return wallet.SyncStatusUpdate{Stage: stage, ConnectedPeers: peers}
+	}
+
+	tests := []struct {
+		policy  Policy
+		publish []wallet.SyncStatusUpdate
+		want    []wallet.SyncStatusUpdate
+		dropped int
+	}{{
+		policy:  DropOldest,
+		publish: []wallet.SyncStatusUpdate{progress(wallet.SyncStarted, 0), progress(wallet.PeersConnected, 1), progress(wallet.PeersConnected, 2)},
+		want:    []wallet.SyncStatusUpdate{progress(wallet.PeersConnected, 1), progress(wallet.PeersConnected, 2)},
+		dropped: 1,
+	}, {
+		policy:  DropNewest,
+		publish: []wallet.SyncStatusUpdate{progress(wallet.SyncStarted, 0), progress(wallet.PeersConnected, 1), progress(wallet.PeersConnected, 2)},
+		want:    []wallet.SyncStatusUpdate{progress(wallet.SyncStarted, 0), progress(wallet.PeersConnected, 1)},
+		dropped: 1,
+	}, {
+		policy:  Coalesce,
+		publish: []wallet.SyncStatusUpdate{progress(wallet.PeersConnected, 1), progress(wallet.SyncStarted, 0), progress(wallet.PeersConnected, 2)},
+		want:    []wallet.SyncStatusUpdate{progress(wallet.PeersConnected, 2), progress(wallet.SyncStarted, 0)},
+	}}
+
+	for _, test := range tests {
+		sub := &Subscription{
+			topics:    map[Topic]bool{SyncTopic: true},
+			policy:    test.policy,
+			queueSize: 2,
+			notify:    make(chan struct{}, 1),
+		}
+		for _, event := range test.publish {
+			sub.push(event)
+		}
+
+		if len(sub.queue) != len(test.want) {
+			t.Fatalf("policy %d: queued %v, want %v", test.policy, sub.queue, test.want)
+		}
+		for i, event := range sub.queue {
+			if event != test.want[i] {
+				t.Fatalf("policy %d: queued %v, want %v", test.policy, sub.queue, test.want)
+			}
+		}
+		if sub.Dropped() != test.dropped {
+			t.Fatalf("policy %d: dropped %d events, want %d", test.policy, sub.Dropped(), test.dropped)
+		}
+	}
+}
+
+func TestSubscriptionEndsWithContext(t *testing.T) {
+	bus := NewBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := bus.Subscribe(ctx, DropOldest, SyncTopic)
+	cancel()
+
+	select {
+	case _, ok := <-sub.Events():
+		if ok {
+			t.Fatal("event delivered after the context was canceled")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("events channel not closed")
+	}
+
+	// Publishing after the subscription ended must not block.
+	bus.Publish(wallet.SyncStatusUpdate{Stage: wallet.SyncStarted})
+}
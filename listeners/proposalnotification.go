@@ -6,15 +6,14 @@ import (
 )
 
 // ProposalNotificationListener satisfies dcrlibwallet
-// ProposalNotificationListener interface contract.
+// ProposalNotificationListener interface contract. It publishes proposal
+// notifications on the bus under ProposalTopic.
 type ProposalNotificationListener struct {
-	ProposalNotifChan chan wallet.Proposal
+	bus *Bus
 }
 
-func NewProposalNotificationListener() *ProposalNotificationListener {
-	return &ProposalNotificationListener{
-		ProposalNotifChan: make(chan wallet.Proposal, 4),
-	}
+func NewProposalNotificationListener(bus *Bus) *ProposalNotificationListener {
+	return &ProposalNotificationListener{bus: bus}
 }
 
 func (pn *ProposalNotificationListener) OnProposalsSynced() {
@@ -47,5 +46,5 @@ func (pn *ProposalNotificationListener) OnProposalVoteFinished(proposal *dcrlibw
 }
 
 func (pn *ProposalNotificationListener) sendNotification(signal wallet.Proposal) {
-	pn.ProposalNotifChan <- signal
+	pn.bus.Publish(signal)
 }
@@ -6,15 +6,13 @@ import (
 )
 
 // SyncProgressListener satisfies dcrlibwallet SyncProgressListener interface
-// contract.
+// contract. It publishes sync updates on the bus under SyncTopic.
 type SyncProgressListener struct {
-	SyncStatusChan chan wallet.SyncStatusUpdate
+	bus *Bus
 }
 
-func NewSyncProgress() *SyncProgressListener {
-	return &SyncProgressListener{
-		SyncStatusChan: make(chan wallet.SyncStatusUpdate, 4),
-	}
+func NewSyncProgress(bus *Bus) *SyncProgressListener {
+	return &SyncProgressListener{bus: bus}
 }
 
 func (sp *SyncProgressListener) OnSyncStarted(wasRestarted bool) {
@@ -68,9 +66,16 @@ func (sp *SyncProgressListener) OnSyncCanceled(willRestart bool) {
 		Stage: wallet.SyncCanceled,
 	})
 }
-func (sp *SyncProgressListener) OnSyncEndedWithError(err error)          {}
+
+func (sp *SyncProgressListener) OnSyncEndedWithError(err error) {
+	sp.sendNotification(wallet.SyncStatusUpdate{
+		Stage: wallet.SyncEndedWithError,
+		Err:   err,
+	})
+}
+
 func (sp *SyncProgressListener) Debug(debugInfo *dcrlibwallet.DebugInfo) {}
 
 func (sp *SyncProgressListener) sendNotification(signal wallet.SyncStatusUpdate) {
-	sp.SyncStatusChan <- signal
+	sp.bus.Publish(signal)
 }
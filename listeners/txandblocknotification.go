@@ -7,15 +7,14 @@ import (
 )
 
 // TxAndBlockNotificationListener satisfies dcrlibwallet
-// TxAndBlockNotificationListener interface contract.
+// TxAndBlockNotificationListener interface contract. It publishes
+// notifications on the bus under TxAndBlockTopic.
 type TxAndBlockNotificationListener struct {
-	TxAndBlockNotifChan chan TxNotification
+	bus *Bus
 }
 
-func NewTxAndBlockNotificationListener() *TxAndBlockNotificationListener {
-	return &TxAndBlockNotificationListener{
-		TxAndBlockNotifChan: make(chan TxNotification, 4),
-	}
+func NewTxAndBlockNotificationListener(bus *Bus) *TxAndBlockNotificationListener {
+	return &TxAndBlockNotificationListener{bus: bus}
 }
 
 func (txAndBlk *TxAndBlockNotificationListener) OnTransaction(transaction string) {
@@ -51,5 +50,5 @@ func (txAndBlk *TxAndBlockNotificationListener) OnTransactionConfirmed(walletID
 }
 
 func (txAndBlk *TxAndBlockNotificationListener) UpdateNotification(signal TxNotification) {
-	txAndBlk.TxAndBlockNotifChan <- signal
+	txAndBlk.bus.Publish(signal)
 }
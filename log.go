@@ -132,3 +132,25 @@ func setLogLevels(logLevel string) {
 		setLogLevel(subsystemID, logLevel)
 	}
 }
+
+// logLevelNames are the names of the log levels used in the debuglevel
+// option.
+var logLevelNames = map[slog.Level]string{
+	slog.LevelTrace:    "trace",
+	slog.LevelDebug:    "debug",
+	slog.LevelInfo:     "info",
+	slog.LevelWarn:     "warn",
+	slog.LevelError:    "error",
+	slog.LevelCritical: "critical",
+	slog.LevelOff:      "off",
+}
+
+// logLevels returns the log level of each subsystem by its name in the
+// debuglevel option.
+func logLevels() map[string]string {
+	levels := make(map[string]string, len(subsystemLoggers))
+	for subsystemID, logger := range subsystemLoggers {
+		levels[subsystemID] = logLevelNames[logger.Level()]
+	}
+	return levels
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"gioui.org/app"
+	"gioui.org/io/system"
 
 	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/ui"
@@ -38,12 +40,30 @@ func main() {
 
 	dcrlibwallet.SetLogLevels(cfg.DebugLevel)
 
+	// The app runs in its own goroutine because gio needs the main
+	// goroutine to show windows, including those shown before the app
+	// window is created.
+	go func() {
+		if err := run(cfg); err != nil {
+			log.Error(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}()
+
+	// Start the GUI frontend.
+	app.Main()
+}
+
+// run starts the app and returns once the app window is closed and the
+// wallets are shut down.
+func run(cfg *config) error {
 	var buildDate time.Time
 	if BuildEnv == wallet.ProdBuild {
+		var err error
 		buildDate, err = time.Parse(time.RFC3339, BuildDate)
 		if err != nil {
-			fmt.Printf("Error: %s\n", err.Error())
-			return
+			return err
 		}
 	} else {
 		buildDate = time.Now()
@@ -52,36 +72,142 @@ func main() {
 	var net string
 	switch cfg.Network {
 	case "testnet":
-		net = "testnet3"
+		net = dcrlibwallet.Testnet3
 	default:
 		net = cfg.Network
 	}
 
+	lock, err := lockAppData(cfg)
+	if err != nil {
+		return fmt.Errorf("app data lock error: %v", err)
+	}
+	if lock == nil {
+		return nil
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			log.Errorf("Unable to release the app data lock: %v", err)
+		}
+	}()
+
 	logFile := filepath.Join(cfg.LogDir, defaultLogFilename)
 	wal, err := wallet.NewWallet(cfg.HomeDir, net, Version, logFile, buildDate)
 	if err != nil {
-		log.Error(err)
-		return
+		return err
+	}
+	wal.SetConfigFile(cfg.ConfigFile)
+	wal.SetAppLock(lock)
+
+	if cfg.Proxy != "" || cfg.TorOnly {
+		err = wal.SetProxyOverride(wallet.ProxyConfig{
+			Host:            cfg.Proxy,
+			Username:        cfg.ProxyUser,
+			Password:        cfg.ProxyPass,
+			StreamIsolation: cfg.TorIsolation,
+			TorOnly:         cfg.TorOnly,
+		})
+		if err != nil {
+			return fmt.Errorf("proxy error: %v", err)
+		}
 	}
 
-	err = wal.InitMultiWallet()
+	// The app profile is chosen on the start page if there are profiles
+	// other than the default and none is set in the config.
+	chooseProfile := false
+	if cfg.ProfileName != "" {
+		err = wal.SelectProfile(cfg.ProfileName)
+		if errors.Is(err, wallet.ErrProfileNotExist) {
+			log.Infof("Creating %s profile on %s", cfg.ProfileName, net)
+			if _, err = wal.CreateProfile(cfg.ProfileName, net); err == nil {
+				err = wal.SelectProfile(cfg.ProfileName)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("profile error: %v", err)
+		}
+	} else {
+		profiles, err := wal.Profiles()
+		if err != nil {
+			return fmt.Errorf("profiles error: %v", err)
+		}
+		chooseProfile = len(profiles) > 1
+	}
+
+	if !chooseProfile {
+		err = wal.InitMultiWallet()
+		if err != nil {
+			return fmt.Errorf("init multiwallet error: %v", err)
+		}
+	}
+
+	previous, err := wal.StartSession()
 	if err != nil {
-		log.Errorf("init multiwallet error: %v", err)
-		return
+		log.Warnf("Unable to record the app session: %v", err)
+	} else if previous != nil {
+		log.Warnf("The previous session started at %s did not shut down cleanly", previous.Started.Format(time.RFC1123))
 	}
 
+	wal.OpenArgs(cfg.args)
 	win, err := ui.CreateWindow(wal)
 	if err != nil {
-		log.Errorf("Could not initialize window: %s\ns", err)
-		return
+		wal.EndSession()
+		return fmt.Errorf("could not initialize window: %v", err)
+	}
+
+	// The app quits from the goroutine that panicked after a crash, so
+	// the lock is released there.
+	win.OnCrash = func() {
+		if err := lock.Release(); err != nil {
+			log.Errorf("Unable to release the app data lock: %v", err)
+		}
 	}
 
+	// The log page changes the log levels until the app quits.
+	win.UseLogLevels(logLevels, parseAndSetDebugLevels)
+
+	// Later launches of the app pass their arguments on and bring the
+	// window forward.
 	go func() {
-		win.HandleEvents() // blocks until the app window is closed
-		wal.Shutdown()
-		os.Exit(0)
+		for args := range lock.Requests() {
+			wal.OpenArgs(args)
+			win.Perform(system.ActionRaise)
+			win.Invalidate()
+		}
 	}()
 
-	// Start the GUI frontend.
-	app.Main()
+	win.HandleEvents() // blocks until the app window is closed
+	ui.FinishCriticalTasks(wal)
+	wal.Shutdown()
+	if err := wal.EndSession(); err != nil {
+		log.Errorf("Unable to record the app shutdown: %v", err)
+	}
+	return nil
+}
+
+// lockAppData locks the app data directory so that no other instance of the
+// app opens the same wallets. If it is locked, the arguments are passed to
+// the running instance or the user is asked what to do. A nil lock is
+// returned if the app should quit.
+func lockAppData(cfg *config) (*wallet.InstanceLock, error) {
+	lock, err := wallet.LockAppData(cfg.HomeDir)
+	if errors.Is(err, wallet.ErrInstanceRunning) && len(cfg.args) > 0 {
+		// Payment URIs are opened by the running instance.
+		if sendErr := wallet.SendToRunningInstance(cfg.HomeDir, cfg.args); sendErr == nil {
+			return nil, nil
+		}
+	}
+
+	stale := errors.Is(err, wallet.ErrStaleLock)
+	if !stale && !errors.Is(err, wallet.ErrInstanceRunning) {
+		return lock, err
+	}
+
+	log.Warnf("The app data directory %s is locked: %v", cfg.HomeDir, err)
+	switch ui.AskAppLocked(cfg.HomeDir, stale) {
+	case ui.AppLockShowRunning:
+		return nil, wallet.SendToRunningInstance(cfg.HomeDir, cfg.args)
+	case ui.AppLockTakeOver:
+		return wallet.TakeOverAppData(cfg.HomeDir)
+	}
+	return nil, nil
 }
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"os"
+	"runtime/debug"
+	"time"
+)
+
+// crashShutdownTimeout is how long the wallets have to shut down after a
+// crash.
+const crashShutdownTimeout = 10 * time.Second
+
+// recoverPanic is deferred by the frame loop so that a panic in the Layout or
+// HandleUserInteractions of a page or modal is reported.
+func (win *Window) recoverPanic() {
+	if r := recover(); r != nil {
+		win.crash(r, debug.Stack())
+	}
+}
+
+// crash writes the report of a panic with panicValue, shuts the wallets down
+// so their databases are closed and quits. A panic of another goroutine while
+// the first is handled waits for the app to quit.
+func (win *Window) crash(panicValue interface{}, stack []byte) {
+	win.crashOnce.Do(func() {
+		log.Criticalf("panic: %v\n%s", panicValue, stack)
+
+		report := win.wallet.NewCrashReport(panicValue, stack, win.pageIDs())
+		if err := win.wallet.SaveCrashReport(report); err != nil {
+			log.Errorf("Unable to save the crash report: %v", err)
+		}
+
+		done := make(chan struct{})
+		go func() {
+			win.wallet.Shutdown()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(crashShutdownTimeout):
+			log.Error("The wallets did not shut down after the crash")
+		}
+
+		if win.OnCrash != nil {
+			win.OnCrash()
+		}
+		os.Exit(2)
+	})
+}
+
+// pageIDs returns the IDs of the displayed pages and of the modal on top.
+func (win *Window) pageIDs() []string {
+	ids := win.navigator.PageIDs()
+	if modal := win.navigator.TopModal(); modal != nil {
+		ids = append(ids, "modal:"+modal.ID())
+	}
+	return ids
+}
@@ -231,7 +231,11 @@ func (e Editor) editor(gtx layout.Context) layout.Dimensions {
 						Top:    e.m5,
 						Bottom: e.m5,
 					}
-					return inset.Layout(gtx, e.EditorStyle.Layout)
+					return inset.Layout(gtx, func(gtx C) D {
+						dims := e.EditorStyle.Layout(gtx)
+						e.t.windowFocus.editorLaidOut(e.Editor)
+						return dims
+					})
 				}),
 			)
 		}),
@@ -0,0 +1,115 @@
+package decredmaterial
+
+import (
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+)
+
+// WindowFocus tells when the app window loses the focus. Gio sends the
+// key.FocusEvent of the window to the key handler that has the focus, not on
+// Window.Events, so WindowFocus holds the key focus itself while no editor
+// has it, and the editors of the theme report their focus to it. Since the
+// key events of an editor are only sent to the editor, WindowFocus also tells
+// when the focused editor was edited.
+type WindowFocus struct {
+	// keyFocused is true while the WindowFocus tag has the key focus and
+	// lost while the window does not have the focus.
+	keyFocused bool
+	lost       bool
+	edited     bool
+
+	// editors are the editors laid out in this frame and their state.
+	// focusedEditors were focused in the previous frame.
+	editors        map[*widget.Editor]editorState
+	focusedEditors map[*widget.Editor]editorState
+}
+
+// editorState is the focus of an editor, the length of its text and its
+// selection. Typing in a focused editor changes the length or the caret.
+type editorState struct {
+	focused    bool
+	length     int
+	start, end int
+}
+
+// WindowFocus returns the focus of the window the theme draws.
+func (t *Theme) WindowFocus() *WindowFocus {
+	return &t.windowFocus
+}
+
+// editorLaidOut records the focus of editor after it processed the events of
+// the frame.
+func (f *WindowFocus) editorLaidOut(editor *widget.Editor) {
+	if f.editors == nil {
+		f.editors = make(map[*widget.Editor]editorState)
+	}
+	start, end := editor.Selection()
+	f.editors[editor] = editorState{
+		focused: editor.Focused(),
+		length:  editor.Len(),
+		start:   start,
+		end:     end,
+	}
+}
+
+// Edited returns true if the text or the caret of a focused editor changed
+// in the frame passed to the last call to Frame.
+func (f *WindowFocus) Edited() bool {
+	return f.edited
+}
+
+// Frame must be called at the end of each frame, after the editors are laid
+// out. It returns true if the window lost the focus since the previous frame.
+func (f *WindowFocus) Frame(gtx layout.Context) bool {
+	tagLost := false
+	for _, e := range gtx.Events(f) {
+		if e, ok := e.(key.FocusEvent); ok {
+			f.keyFocused = e.Focus
+			tagLost = tagLost || !e.Focus
+			if e.Focus {
+				f.lost = false
+			}
+		}
+	}
+
+	// An editor that is still shown but lost the focus, without another
+	// editor taking it, was told that the window lost the focus.
+	editorFocused, editorLost := false, false
+	f.edited = false
+	focusedEditors := make(map[*widget.Editor]editorState)
+	for editor, state := range f.editors {
+		previous, wasFocused := f.focusedEditors[editor]
+		switch {
+		case state.focused:
+			editorFocused = true
+			focusedEditors[editor] = state
+			f.edited = f.edited || wasFocused && state != previous
+		case wasFocused:
+			editorLost = true
+		}
+	}
+	f.editors, f.focusedEditors = nil, focusedEditors
+
+	lost := false
+	switch {
+	case editorFocused:
+		// The tag lost the focus to an editor.
+		f.lost = false
+	case tagLost || editorLost:
+		lost = !f.lost
+		f.lost = true
+	}
+
+	// The key ops are deferred so that they are added after the ops that
+	// pages defer.
+	m := op.Record(gtx.Ops)
+	key.InputOp{Tag: f}.Add(gtx.Ops)
+	if !f.keyFocused && !editorFocused && !f.lost {
+		key.FocusOp{Tag: f}.Add(gtx.Ops)
+	}
+	op.Defer(gtx.Ops, m.Stop())
+
+	return lost
+}
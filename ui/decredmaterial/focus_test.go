@@ -0,0 +1,93 @@
+package decredmaterial
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/font/gofont"
+	"gioui.org/io/key"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+func TestWindowFocus(t *testing.T) {
+	var (
+		r      router.Router
+		ops    op.Ops
+		focus  WindowFocus
+		editor = new(widget.Editor)
+		shaper = text.NewCache(gofont.Collection())
+	)
+
+	// frame lays out a frame like the app window and returns whether the
+	// window lost the focus.
+	frame := func(showEditor bool) bool {
+		ops.Reset()
+		gtx := layout.Context{
+			Ops:         &ops,
+			Queue:       &r,
+			Constraints: layout.Exact(image.Pt(200, 100)),
+		}
+		if showEditor {
+			editor.Layout(gtx, shaper, text.Font{}, unit.Sp(14), nil)
+			focus.editorLaidOut(editor)
+		}
+		lost := focus.Frame(gtx)
+		r.Frame(&ops)
+		return lost
+	}
+	step := func(name string, showEditor, wantLost bool) {
+		t.Helper()
+		if lost := frame(showEditor); lost != wantLost {
+			t.Fatalf("%s: lost %v, want %v", name, lost, wantLost)
+		}
+	}
+
+	step("take the key focus", false, false)
+	step("key focus taken", false, false)
+	if !focus.keyFocused {
+		t.Fatal("the key focus was not taken")
+	}
+
+	r.Queue(key.FocusEvent{Focus: false})
+	step("window loses focus", false, true)
+	step("window still unfocused", false, false)
+	r.Queue(key.FocusEvent{Focus: true})
+	step("window regains focus", false, false)
+
+	editor.Focus()
+	step("editor requests focus", true, false)
+	step("editor takes focus", true, false)
+	if !editor.Focused() {
+		t.Fatal("the editor is not focused")
+	}
+	if focus.Edited() {
+		t.Fatal("the editor was edited before typing")
+	}
+
+	r.Queue(key.EditEvent{Text: "a"})
+	step("typing in the editor", true, false)
+	if !focus.Edited() {
+		t.Fatal("typing in the editor was not seen")
+	}
+	step("editor idle", true, false)
+	if focus.Edited() {
+		t.Fatal("the editor was edited without typing")
+	}
+
+	r.Queue(key.FocusEvent{Focus: false})
+	step("window loses focus while editing", true, true)
+	r.Queue(key.FocusEvent{Focus: true})
+	step("window regains focus while editing", true, false)
+
+	// Leaving the page of the editor does not look like a focus loss.
+	step("editor hidden", false, false)
+	step("key focus taken back", false, false)
+	step("key focus held", false, false)
+	r.Queue(key.FocusEvent{Focus: false})
+	step("window loses focus after editing", false, true)
+}
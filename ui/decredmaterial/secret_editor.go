@@ -0,0 +1,35 @@
+package decredmaterial
+
+import (
+	"io"
+	"math"
+	"strings"
+
+	"gioui.org/widget"
+)
+
+// EditorBytes returns the text of editor as a byte slice. Unlike
+// widget.Editor.Text, it doesn't copy the text into an immutable string, so
+// secrets such as passphrases can be zeroed once they are no longer needed.
+func EditorBytes(editor *widget.Editor) []byte {
+	// Seeking past the end stops at the end of the text, which gives its
+	// size in bytes without reading partial copies of it.
+	size, _ := editor.Seek(math.MaxInt32, io.SeekStart)
+
+	text := make([]byte, size)
+	editor.Seek(0, io.SeekStart)
+	io.ReadFull(editor, text)
+	return text
+}
+
+// WipeEditor overwrites the text of editor in place with filler of the same
+// length and then clears it. SetText alone swaps in a new text buffer and
+// leaves the old one, secret included, to the garbage collector. This is best
+// effort: gio copies the text into a larger buffer as the user types, and
+// those earlier copies can't be reached from here.
+func WipeEditor(editor *widget.Editor) {
+	size, _ := editor.Seek(math.MaxInt32, io.SeekStart)
+	editor.SetCaret(editor.Len(), 0)
+	editor.Insert(strings.Repeat("*", int(size)))
+	editor.SetText("")
+}
@@ -0,0 +1,28 @@
+package decredmaterial
+
+import (
+	"testing"
+
+	"gioui.org/widget"
+)
+
+func TestEditorBytes(t *testing.T) {
+	editor := new(widget.Editor)
+	for _, text := range []string{"", "passphrase", "pässphrasé 密码"} {
+		editor.SetText(text)
+		if got := string(EditorBytes(editor)); got != text {
+			t.Errorf("got %q, want %q", got, text)
+		}
+	}
+}
+
+func TestWipeEditor(t *testing.T) {
+	editor := new(widget.Editor)
+	for _, text := range []string{"", "passphrase", "pässphrasé 密码"} {
+		editor.SetText(text)
+		WipeEditor(editor)
+		if got := editor.Text(); got != "" {
+			t.Errorf("got %q after wiping %q, want empty text", got, text)
+		}
+	}
+}
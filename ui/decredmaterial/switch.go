@@ -219,6 +219,16 @@ func (s *SwitchButtonText) SelectedIndex() int {
 	return s.selected
 }
 
+// SetSelectedIndex selects the item at index, counting from 1 like
+// SelectedIndex.
+func (s *SwitchButtonText) SetSelectedIndex(index int) {
+	if index < 1 || index >= len(s.items) || index == s.selected {
+		return
+	}
+	s.selected = index
+	s.changed = true
+}
+
 func (s *SwitchButtonText) Changed() bool {
 	changed := s.changed
 	s.changed = false
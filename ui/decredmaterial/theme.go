@@ -48,6 +48,8 @@ type Theme struct {
 	collapseIcon          *Image
 
 	dropDownMenus []*DropDown
+
+	windowFocus WindowFocus
 }
 
 func NewTheme(fontCollection []text.FontFace, decredIcons map[string]image.Image, isDarkModeOn bool) *Theme {
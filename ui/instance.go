@@ -0,0 +1,98 @@
+package ui
+
+import (
+	giouiApp "gioui.org/app"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+
+	"github.com/planetdecred/godcr/ui/assets"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/values"
+)
+
+// AppLockChoice is what the user chose to do when the app data directory is
+// locked by another instance of the app.
+type AppLockChoice int
+
+const (
+	AppLockQuit AppLockChoice = iota
+	AppLockShowRunning
+	AppLockTakeOver
+)
+
+// AskAppLocked shows a window that tells the user the app data directory at
+// homeDir is locked and returns what the user chose. If stale is true the
+// instance that holds the lock does not respond, and the lock can be taken
+// over. Otherwise the running instance can be brought forward. It must be
+// called while app.Main runs.
+func AskAppLocked(homeDir string, stale bool) AppLockChoice {
+	th := decredmaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+
+	title, info := values.String(values.StrAppRunning), values.StringF(values.StrAppRunningInfo, homeDir)
+	action, choice := th.Button(values.String(values.StrShowRunningApp)), AppLockShowRunning
+	if stale {
+		title, info = values.String(values.StrStaleAppLock), values.StringF(values.StrStaleAppLockInfo, homeDir)
+		action, choice = th.Button(values.String(values.StrTakeOver)), AppLockTakeOver
+	}
+	quit := th.OutlineButton(values.String(values.StrExit))
+
+	w := giouiApp.NewWindow(
+		giouiApp.Size(values.AppWidth, unit.Dp(240)),
+		giouiApp.Title(title),
+	)
+
+	chosen := AppLockQuit
+	var ops op.Ops
+	for e := range w.Events() {
+		switch evt := e.(type) {
+		case system.DestroyEvent:
+			return chosen
+
+		case system.FrameEvent:
+			gtx := layout.NewContext(&ops, evt)
+			if action.Clicked() {
+				chosen = choice
+				w.Perform(system.ActionClose)
+			}
+			if quit.Clicked() {
+				w.Perform(system.ActionClose)
+			}
+
+			layout.Stack{}.Layout(gtx,
+				layout.Expanded(func(gtx C) D {
+					return decredmaterial.Fill(gtx, th.Color.Surface)
+				}),
+				layout.Stacked(func(gtx C) D {
+					return layout.UniformInset(values.MarginPadding24).Layout(gtx, func(gtx C) D {
+						return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+							layout.Rigid(func(gtx C) D {
+								lbl := th.H6(title)
+								lbl.Font.Weight = text.SemiBold
+								return lbl.Layout(gtx)
+							}),
+							layout.Rigid(func(gtx C) D {
+								lbl := th.Body1(info)
+								lbl.Color = th.Color.GrayText2
+								return layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding16}.Layout(gtx, lbl.Layout)
+							}),
+							layout.Rigid(func(gtx C) D {
+								return layout.E.Layout(gtx, func(gtx C) D {
+									return layout.Flex{}.Layout(gtx,
+										layout.Rigid(quit.Layout),
+										layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+										layout.Rigid(action.Layout),
+									)
+								})
+							}),
+						)
+					})
+				}),
+			)
+			evt.Frame(gtx.Ops)
+		}
+	}
+	return chosen
+}
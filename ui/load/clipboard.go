@@ -0,0 +1,78 @@
+package load
+
+import (
+	"strconv"
+	"time"
+
+	"gioui.org/io/clipboard"
+	"gioui.org/layout"
+
+	"github.com/planetdecred/godcr/ui/values"
+)
+
+// maxCopiedAddresses is the number of addresses copied from the app that are
+// remembered to detect look-alike addresses pasted on the send page.
+const maxCopiedAddresses = 10
+
+// clipboardState tracks what the app wrote to the clipboard. It is only
+// accessed from the window's event handling goroutine.
+type clipboardState struct {
+	copiedAddresses []string
+
+	// sensitiveText is the last sensitive text copied by the app. It is
+	// cleared from the clipboard at clearAt if the clipboard still holds it.
+	sensitiveText string
+	clearAt       time.Time
+}
+
+// CopyAddress writes address to the clipboard. The address is cleared from
+// the clipboard after the user-configured delay and is remembered to warn
+// about look-alike addresses pasted as a send destination.
+func (l *Load) CopyAddress(gtx layout.Context, address string) {
+	l.clipboard.copiedAddresses = append(l.clipboard.copiedAddresses, address)
+	if len(l.clipboard.copiedAddresses) > maxCopiedAddresses {
+		l.clipboard.copiedAddresses = l.clipboard.copiedAddresses[1:]
+	}
+	l.CopySensitiveText(gtx, address)
+}
+
+// CopySensitiveText writes text to the clipboard and schedules it to be
+// cleared after the user-configured delay.
+func (l *Load) CopySensitiveText(gtx layout.Context, text string) {
+	clipboard.WriteOp{Text: text}.Add(gtx.Ops)
+
+	l.clipboard.sensitiveText = ""
+	delay := l.WL.MultiWallet.ReadStringConfigValueForKey(ClipboardClearDelayConfigKey)
+	if delay == "" {
+		delay = values.DefaultClipboardClearDelay
+	}
+	seconds, err := strconv.Atoi(delay)
+	if err != nil || seconds <= 0 {
+		return
+	}
+	l.clipboard.sensitiveText = text
+	l.clipboard.clearAt = time.Now().Add(time.Duration(seconds) * time.Second)
+}
+
+// CopiedAddresses returns the addresses most recently copied from the app.
+func (l *Load) CopiedAddresses() []string {
+	return l.clipboard.copiedAddresses
+}
+
+// ClipboardClearDue returns true if sensitive text copied by the app should
+// now be cleared from the clipboard.
+func (l *Load) ClipboardClearDue() bool {
+	return l.clipboard.sensitiveText != "" && !time.Now().Before(l.clipboard.clearAt)
+}
+
+// ShouldClearClipboard returns true if text, the current content of the
+// clipboard, is the sensitive text copied by the app and is due to be cleared.
+// Anything copied since by the user is left alone.
+func (l *Load) ShouldClearClipboard(text string) bool {
+	if !l.ClipboardClearDue() {
+		return false
+	}
+	shouldClear := text == l.clipboard.sensitiveText
+	l.clipboard.sensitiveText = ""
+	return shouldClear
+}
@@ -6,13 +6,19 @@
 package load
 
 import (
+	"runtime/debug"
+	"strconv"
+	"strings"
+
 	"golang.org/x/text/message"
 
 	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/listeners"
 	"github.com/planetdecred/godcr/ui/assets"
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/notification"
+	"github.com/planetdecred/godcr/ui/values"
 	"github.com/planetdecred/godcr/wallet"
 )
 
@@ -30,15 +36,41 @@ type Load struct {
 
 	Toast *notification.Toast
 
+	// Bus delivers the wallet notifications that pages subscribe to.
+	Bus *listeners.Bus
+
 	SelectedUTXO map[int]map[int32]map[string]*wallet.UnspentOutput
 
 	ToggleSync func()
 
+	// privacyMode caches the persisted privacy mode setting since it is
+	// checked every time an amount is drawn. It is nil until first read.
+	privacyMode *bool
+
+	clipboard clipboardState
+
 	DarkModeSettingChanged func(bool)
 	LanguageSettingChanged func()
 	CurrencySettingChanged func()
+
+	// ProfileSelected is called after the multiwallet of the app profile
+	// chosen on the start page is loaded.
+	ProfileSelected func()
+
+	// Crashed writes a crash report for a panic with panicValue and quits
+	// the app. stack is the stack trace of the goroutine that panicked.
+	Crashed func(panicValue interface{}, stack []byte)
+
+	// LogLevels returns the log level of each subsystem and SetLogLevels
+	// changes them with a value of the debuglevel option, such as
+	// "WALL=debug". They are nil if the log levels cannot be changed.
+	LogLevels    func() map[string]string
+	SetLogLevels func(debugLevel string) error
 }
 
+// maskedAmount replaces the digits of amounts while privacy mode is on.
+const maskedAmount = "******"
+
 func (l *Load) RefreshTheme(window app.WindowNavigator) {
 	isDarkModeOn := l.WL.MultiWallet.ReadBoolConfigValueForKey(DarkModeConfigKey, false)
 	l.Theme.SwitchDarkMode(isDarkModeOn, assets.DecredIcons)
@@ -48,6 +80,60 @@ func (l *Load) RefreshTheme(window app.WindowNavigator) {
 	window.Reload()
 }
 
+// RecoverPanic is deferred by the goroutines the pages start, such as those
+// that receive wallet notifications, so that their panics are reported like
+// the panics of the window.
+func (l *Load) RecoverPanic() {
+	if r := recover(); r != nil {
+		if l.Crashed == nil {
+			panic(r)
+		}
+		l.Crashed(r, debug.Stack())
+	}
+}
+
 func (l *Load) Dexc() *dcrlibwallet.DexClient {
 	return l.WL.MultiWallet.DexClient()
 }
+
+// IsPrivacyModeOn returns true if amounts and fiat values should be masked on
+// every page.
+func (l *Load) IsPrivacyModeOn() bool {
+	if l.privacyMode == nil {
+		isOn := l.WL.MultiWallet.ReadBoolConfigValueForKey(HideBalanceConfigKey, false)
+		l.privacyMode = &isOn
+	}
+	return *l.privacyMode
+}
+
+// SetPrivacyMode turns privacy mode on or off and persists the setting.
+func (l *Load) SetPrivacyMode(isOn bool) {
+	l.privacyMode = &isOn
+	l.WL.MultiWallet.SetBoolConfigValueForKey(HideBalanceConfigKey, isOn)
+}
+
+// MinPasswordScore returns the lowest strength score, from 0 to
+// wallet.MaxPasswordScore, that new passwords must have.
+func (l *Load) MinPasswordScore() int {
+	minScore := l.WL.MultiWallet.ReadStringConfigValueForKey(MinPasswordScoreConfigKey)
+	if minScore == "" {
+		minScore = values.DefaultMinPasswordScore
+	}
+	score, err := strconv.Atoi(minScore)
+	if err != nil {
+		score, _ = strconv.Atoi(values.DefaultMinPasswordScore)
+	}
+	return score
+}
+
+// MaskAmount returns amount unchanged if privacy mode is off. Otherwise the
+// value is masked, keeping the DCR unit of DCR amounts.
+func (l *Load) MaskAmount(amount string) string {
+	if !l.IsPrivacyModeOn() {
+		return amount
+	}
+	if strings.HasSuffix(amount, " DCR") {
+		return maskedAmount + " DCR"
+	}
+	return maskedAmount
+}
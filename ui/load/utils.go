@@ -1,5 +1,12 @@
 package load
 
+import (
+	"strconv"
+	"time"
+
+	"github.com/planetdecred/godcr/ui/values"
+)
+
 const Uint32Size = 32 // 32 or 64 ? shifting 32-bit value by 32 bits will always clear it
 const MaxInt32 = 1<<(Uint32Size-1) - 1
 
@@ -14,6 +21,12 @@ const (
 	ProposalNotificationConfigKey    = "proposal_notification_key"
 	TransactionNotificationConfigKey = "transaction_notification_key"
 	SpendUnmixedFundsKey             = "spend_unmixed_funds"
+	AutoLockTimeoutConfigKey         = "auto_lock_timeout"
+	SeedAfterPassphraseFailuresKey   = "seed_after_passphrase_failures"
+	PrivacyOnFocusLossConfigKey      = "privacy_on_focus_loss"
+	ClipboardClearDelayConfigKey     = "clipboard_clear_delay"
+	MinPasswordScoreConfigKey        = "min_password_score"
+	SyncStallTimeoutConfigKey        = "sync_stall_timeout"
 )
 
 // SetCurrentAppWidth stores the current width of the app's window.
@@ -25,3 +38,14 @@ func (l *Load) SetCurrentAppWidth(appWidth int) {
 func (l *Load) GetCurrentAppWidth() int {
 	return l.CurrentAppWidth
 }
+
+// SyncStallTimeout returns how long the sync may go without progress before
+// it is shown as stalled.
+func (l *Load) SyncStallTimeout() time.Duration {
+	timeout := l.WL.MultiWallet.ReadStringConfigValueForKey(SyncStallTimeoutConfigKey)
+	if _, ok := values.ArrSyncStallTimeouts[timeout]; !ok {
+		timeout = values.DefaultSyncStallTimeout
+	}
+	seconds, _ := strconv.Atoi(timeout)
+	return time.Duration(seconds) * time.Second
+}
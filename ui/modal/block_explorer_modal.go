@@ -0,0 +1,215 @@
+package modal
+
+import (
+	"gioui.org/io/clipboard"
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// BlockExplorerModal lets the user choose a block explorer preset, turn
+// explorer links off or set the URL templates of a custom explorer.
+type BlockExplorerModal struct {
+	*load.Load
+	*decredmaterial.Modal
+
+	explorerGroup *widget.Enum
+
+	txURL      decredmaterial.Editor
+	addressURL decredmaterial.Editor
+	blockURL   decredmaterial.Editor
+
+	btnPositve  decredmaterial.Button
+	btnNegative decredmaterial.Button
+}
+
+func NewBlockExplorerModal(l *load.Load) *BlockExplorerModal {
+	bm := &BlockExplorerModal{
+		Load:          l,
+		Modal:         l.Theme.ModalFloatTitle("block_explorer_modal"),
+		explorerGroup: new(widget.Enum),
+		btnPositve:    l.Theme.Button(values.String(values.StrSave)),
+		btnNegative:   l.Theme.OutlineButton(values.String(values.StrCancel)),
+	}
+
+	bm.btnPositve.Font.Weight = text.Medium
+
+	bm.btnNegative.Font.Weight = text.Medium
+	bm.btnNegative.Margin = layout.Inset{Right: values.MarginPadding8}
+
+	bm.txURL = l.Theme.Editor(new(widget.Editor), values.String(values.StrExplorerTxURL))
+	bm.addressURL = l.Theme.Editor(new(widget.Editor), values.String(values.StrExplorerAddressURL))
+	bm.blockURL = l.Theme.Editor(new(widget.Editor), values.String(values.StrExplorerBlockURL))
+	for _, e := range []*decredmaterial.Editor{&bm.txURL, &bm.addressURL, &bm.blockURL} {
+		e.Editor.SingleLine = true
+	}
+
+	return bm
+}
+
+func (bm *BlockExplorerModal) OnResume() {
+	bm.explorerGroup.Value = bm.WL.Wallet.BlockExplorer().ID
+
+	custom := bm.WL.Wallet.CustomBlockExplorer()
+	bm.txURL.Editor.SetText(custom.TxURL)
+	bm.addressURL.Editor.SetText(custom.AddressURL)
+	bm.blockURL.Editor.SetText(custom.BlockURL)
+}
+
+func (bm *BlockExplorerModal) OnDismiss() {}
+
+func (bm *BlockExplorerModal) Handle() {
+	for _, e := range []*decredmaterial.Editor{&bm.txURL, &bm.addressURL, &bm.blockURL} {
+		if _, isChanged := decredmaterial.HandleEditorEvents(e.Editor); isChanged {
+			bm.txURL.SetError("")
+		}
+	}
+
+	if bm.btnPositve.Clicked() {
+		var err error
+		if bm.explorerGroup.Value == wallet.BlockExplorerCustom {
+			err = bm.WL.Wallet.SetCustomBlockExplorer(wallet.BlockExplorer{
+				TxURL:      bm.txURL.Editor.Text(),
+				AddressURL: bm.addressURL.Editor.Text(),
+				BlockURL:   bm.blockURL.Editor.Text(),
+			})
+		} else {
+			err = bm.WL.Wallet.SetBlockExplorer(bm.explorerGroup.Value)
+		}
+
+		if err != nil {
+			bm.txURL.SetError(err.Error())
+		} else {
+			bm.Dismiss()
+		}
+	}
+
+	if bm.btnNegative.Clicked() || bm.Modal.BackdropClicked(true) {
+		bm.Dismiss()
+	}
+}
+
+func (bm *BlockExplorerModal) Layout(gtx layout.Context) D {
+	explorers := make([]layout.FlexChild, 0, len(bm.WL.Wallet.BlockExplorers())+2)
+	addExplorer := func(id, name string) {
+		radio := bm.Theme.RadioButton(bm.explorerGroup, id, name, bm.Theme.Color.DeepBlue, bm.Theme.Color.Primary)
+		explorers = append(explorers, layout.Rigid(radio.Layout))
+	}
+	for _, explorer := range bm.WL.Wallet.BlockExplorers() {
+		addExplorer(explorer.ID, explorer.Name)
+	}
+	addExplorer(wallet.BlockExplorerNone, values.String(values.StrExplorerOffline))
+	addExplorer(wallet.BlockExplorerCustom, values.String(values.StrCustomExplorer))
+
+	w := []layout.Widget{
+		func(gtx C) D {
+			t := bm.Theme.H6(values.String(values.StrBlockExplorer))
+			t.Font.Weight = text.SemiBold
+			return t.Layout(gtx)
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, explorers...)
+		},
+		func(gtx C) D {
+			if bm.explorerGroup.Value != wallet.BlockExplorerCustom {
+				return D{}
+			}
+
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					hint := bm.Theme.Caption(values.String(values.StrExplorerTemplateHint))
+					hint.Color = bm.Theme.Color.GrayText2
+					return hint.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, bm.txURL.Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, bm.addressURL.Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, bm.blockURL.Layout)
+				}),
+			)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(bm.btnNegative.Layout),
+					layout.Rigid(bm.btnPositve.Layout),
+				)
+			})
+		},
+	}
+
+	return bm.Modal.Layout(gtx, w)
+}
+
+// BlockExplorerName returns the display name of explorer.
+func BlockExplorerName(explorer wallet.BlockExplorer) string {
+	switch explorer.ID {
+	case wallet.BlockExplorerNone:
+		return values.String(values.StrExplorerOffline)
+	case wallet.BlockExplorerCustom:
+		return values.String(values.StrCustomExplorer)
+	}
+	return explorer.Name
+}
+
+// NewExplorerLinkModal returns a modal that shows explorerURL, a link to the
+// block explorer, so that it can be copied and opened in a browser.
+func NewExplorerLinkModal(l *load.Load, explorer wallet.BlockExplorer, explorerURL string) *InfoModal {
+	explorerName := BlockExplorerName(explorer)
+	copyURL := l.Theme.NewClickable(false)
+	return NewInfoModal(l).
+		Title(values.StringF(values.StrViewOnExplorer, explorerName)).
+		Body(values.StringF(values.StrExplorerLinkInfo, explorerName)).
+		SetCancelable(true).
+		UseCustomWidget(func(gtx C) D {
+			return layout.Stack{}.Layout(gtx,
+				layout.Stacked(func(gtx C) D {
+					border := widget.Border{Color: l.Theme.Color.Gray4, CornerRadius: values.MarginPadding10, Width: values.MarginPadding2}
+					wrapper := l.Theme.Card()
+					wrapper.Color = l.Theme.Color.Gray4
+					return border.Layout(gtx, func(gtx C) D {
+						return wrapper.Layout(gtx, func(gtx C) D {
+							return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
+								return layout.Flex{}.Layout(gtx,
+									layout.Flexed(0.9, l.Theme.Body1(explorerURL).Layout),
+									layout.Flexed(0.1, func(gtx C) D {
+										return layout.E.Layout(gtx, func(gtx C) D {
+											return layout.Inset{Top: values.MarginPadding7}.Layout(gtx, func(gtx C) D {
+												if copyURL.Clicked() {
+													clipboard.WriteOp{Text: explorerURL}.Add(gtx.Ops)
+													l.Toast.Notify("URL copied")
+												}
+												return copyURL.Layout(gtx, l.Theme.Icons.CopyIcon.Layout24dp)
+											})
+										})
+									}),
+								)
+							})
+						})
+					})
+				}),
+				layout.Stacked(func(gtx C) D {
+					return layout.Inset{
+						Top:  values.MarginPaddingMinus10,
+						Left: values.MarginPadding10,
+					}.Layout(gtx, func(gtx C) D {
+						label := l.Theme.Body2("Web URL")
+						label.Color = l.Theme.Color.GrayText2
+						return label.Layout(gtx)
+					})
+				}),
+			)
+		}).
+		PositiveButton("Got it", func(isChecked bool) bool {
+			return true
+		})
+}
@@ -1,6 +1,7 @@
 package modal
 
 import (
+	"bytes"
 	"strconv"
 
 	"gioui.org/io/key"
@@ -14,6 +15,7 @@ import (
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type CreatePasswordModal struct {
@@ -25,6 +27,11 @@ type CreatePasswordModal struct {
 	confirmPasswordEditor decredmaterial.Editor
 	passwordStrength      decredmaterial.ProgressBarStyle
 
+	strength wallet.PasswordStrength
+	// userInputs are words an attacker may know that make the password
+	// easier to guess, such as the wallet name.
+	userInputs []string
+
 	isLoading          bool
 	isCancelable       bool
 	walletNameEnabled  bool
@@ -43,7 +50,11 @@ type CreatePasswordModal struct {
 	btnNegative           decredmaterial.Button
 	negativeButtonClicked func()
 
-	callback func(walletName, password string, m *CreatePasswordModal) bool // return true to dismiss dialog
+	// passphrase is the created password passed to the callback. It is
+	// zeroed when loading stops or the modal is dismissed.
+	passphrase []byte
+
+	callback func(walletName string, password []byte, m *CreatePasswordModal) bool // return true to dismiss dialog
 }
 
 func NewCreatePasswordModal(l *load.Load) *CreatePasswordModal {
@@ -83,7 +94,11 @@ func (cm *CreatePasswordModal) OnResume() {
 	}
 }
 
-func (cm *CreatePasswordModal) OnDismiss() {}
+func (cm *CreatePasswordModal) OnDismiss() {
+	wallet.ZeroBytes(cm.passphrase)
+	decredmaterial.WipeEditor(cm.passwordEditor.Editor)
+	decredmaterial.WipeEditor(cm.confirmPasswordEditor.Editor)
+}
 
 func (cm *CreatePasswordModal) Title(title string) *CreatePasswordModal {
 	cm.dialogTitle = title
@@ -105,12 +120,23 @@ func (cm *CreatePasswordModal) ConfirmPasswordHint(hint string) *CreatePasswordM
 	return cm
 }
 
+// UserInputs sets words that an attacker may know, such as the name of the
+// wallet, which are easy to guess when used in the password.
+func (cm *CreatePasswordModal) UserInputs(inputs ...string) *CreatePasswordModal {
+	cm.userInputs = inputs
+	return cm
+}
+
 func (cm *CreatePasswordModal) ShowWalletInfoTip(show bool) *CreatePasswordModal {
 	cm.showWalletWarnInfo = show
 	return cm
 }
 
-func (cm *CreatePasswordModal) PasswordCreated(callback func(walletName, password string, m *CreatePasswordModal) bool) *CreatePasswordModal {
+// PasswordCreated sets the callback that receives the created password. The
+// password is zeroed once the callback dismisses the modal or calls
+// SetLoading(false), so callbacks that need it afterwards must copy it and
+// zero the copy with wallet.ZeroBytes when done.
+func (cm *CreatePasswordModal) PasswordCreated(callback func(walletName string, password []byte, m *CreatePasswordModal) bool) *CreatePasswordModal {
 	cm.callback = callback
 	return cm
 }
@@ -123,6 +149,9 @@ func (cm *CreatePasswordModal) NegativeButton(callback func()) *CreatePasswordMo
 func (cm *CreatePasswordModal) SetLoading(loading bool) {
 	cm.isLoading = loading
 	cm.Modal.SetDisabled(loading)
+	if !loading {
+		wallet.ZeroBytes(cm.passphrase)
+	}
 }
 
 func (cm *CreatePasswordModal) SetCancelable(min bool) *CreatePasswordModal {
@@ -172,6 +201,12 @@ func (cm *CreatePasswordModal) Handle() {
 		cm.walletName.SetError("")
 		cm.passwordEditor.SetError("")
 		cm.confirmPasswordEditor.SetError("")
+
+		userInputs := cm.userInputs
+		if cm.walletNameEnabled {
+			userInputs = append([]string{cm.walletName.Editor.Text()}, userInputs...)
+		}
+		cm.strength = computePasswordStrength(&cm.passwordStrength, cm.Theme, cm.passwordEditor.Editor, userInputs...)
 	}
 
 	if (cm.btnPositve.Clicked() || isSubmit) && cm.isEnabled {
@@ -193,10 +228,16 @@ func (cm *CreatePasswordModal) Handle() {
 			return
 		}
 
+		if cm.strength.Score < cm.MinPasswordScore() {
+			cm.passwordEditor.SetError(values.String(values.StrPasswordTooWeak))
+			return
+		}
+
 		if cm.passwordsMatch(cm.passwordEditor.Editor, cm.confirmPasswordEditor.Editor) {
 
 			cm.SetLoading(true)
-			if cm.callback(cm.walletName.Editor.Text(), cm.passwordEditor.Editor.Text(), cm) {
+			cm.passphrase = decredmaterial.EditorBytes(cm.passwordEditor.Editor)
+			if cm.callback(cm.walletName.Editor.Text(), cm.passphrase, cm) {
 				cm.Dismiss()
 			}
 		}
@@ -209,16 +250,16 @@ func (cm *CreatePasswordModal) Handle() {
 				cm.parent.OnNavigatedTo()
 			}
 			cm.Dismiss()
+			cm.cancelled()
 		}
 	}
 
 	if cm.Modal.BackdropClicked(cm.isCancelable) {
 		if !cm.isLoading {
 			cm.Dismiss()
+			cm.cancelled()
 		}
 	}
-
-	computePasswordStrength(&cm.passwordStrength, cm.Theme, cm.passwordEditor.Editor)
 }
 
 // KeysToHandle returns an expression that describes a set of key combinations
@@ -240,15 +281,23 @@ func (cm *CreatePasswordModal) HandleKeyPress(evt *key.Event) {
 	}
 }
 
+func (cm *CreatePasswordModal) cancelled() {
+	if cm.negativeButtonClicked != nil {
+		cm.negativeButtonClicked()
+	}
+}
+
 func (cm *CreatePasswordModal) passwordsMatch(editors ...*widget.Editor) bool {
 	if len(editors) < 2 {
 		return false
 	}
 
-	password := editors[0]
-	matching := editors[1]
+	password := decredmaterial.EditorBytes(editors[0])
+	matching := decredmaterial.EditorBytes(editors[1])
+	defer wallet.ZeroBytes(password)
+	defer wallet.ZeroBytes(matching)
 
-	if password.Text() != matching.Text() {
+	if !bytes.Equal(password, matching) {
 		cm.confirmPasswordEditor.SetError(values.String(values.StrPasswordNotMatch))
 		return false
 	}
@@ -257,6 +306,34 @@ func (cm *CreatePasswordModal) passwordsMatch(editors ...*widget.Editor) bool {
 	return true
 }
 
+// strengthFeedbackLayout shows the estimated time needed to guess the password
+// and advice for choosing a stronger password.
+func (cm *CreatePasswordModal) strengthFeedbackLayout(gtx C) D {
+	if cm.passwordEditor.Editor.Len() == 0 {
+		return D{}
+	}
+
+	crackTime := cm.Theme.Label(values.TextSize12, values.StringF(values.StrPasswordCrackTime, crackTimeText(cm.strength.CrackTime)))
+	crackTime.Color = cm.Theme.Color.GrayText1
+	feedback := []layout.FlexChild{layout.Rigid(crackTime.Layout)}
+
+	if warning, ok := passwordWarnings[cm.strength.Warning]; ok {
+		txt := cm.Theme.Label(values.TextSize12, values.String(warning))
+		txt.Color = cm.Theme.Color.Danger
+		feedback = append(feedback, layout.Rigid(txt.Layout))
+	}
+
+	for _, suggestion := range cm.strength.Suggestions {
+		txt := cm.Theme.Label(values.TextSize12, values.String(passwordTips[suggestion]))
+		txt.Color = cm.Theme.Color.GrayText2
+		feedback = append(feedback, layout.Rigid(txt.Layout))
+	}
+
+	return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, feedback...)
+	})
+}
+
 func (cm *CreatePasswordModal) titleLayout() layout.Widget {
 	return func(gtx C) D {
 		t := cm.Theme.H6(cm.dialogTitle)
@@ -318,7 +395,12 @@ func (cm *CreatePasswordModal) Layout(gtx C) D {
 		)
 	})
 
-	w = append(w, cm.passwordStrength.Layout)
+	w = append(w, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(cm.passwordStrength.Layout),
+			layout.Rigid(cm.strengthFeedbackLayout),
+		)
+	})
 	w = append(w, func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(cm.confirmPasswordEditor.Layout),
@@ -0,0 +1,151 @@
+package modal
+
+import (
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+type CreateProfileModal struct {
+	*load.Load
+	*decredmaterial.Modal
+
+	materialLoader material.LoaderStyle
+
+	profileName  decredmaterial.Editor
+	networkGroup *widget.Enum
+
+	btnPositve  decredmaterial.Button
+	btnNegative decredmaterial.Button
+
+	isLoading    bool
+	isCancelable bool
+
+	callback func(name, network string, m *CreateProfileModal) bool // return true to dismiss dialog
+}
+
+func NewCreateProfileModal(l *load.Load) *CreateProfileModal {
+	cm := &CreateProfileModal{
+		Load:         l,
+		Modal:        l.Theme.ModalFloatTitle("create_profile_modal"),
+		networkGroup: &widget.Enum{Value: dcrlibwallet.Mainnet},
+		btnPositve:   l.Theme.Button(values.String(values.StrCreate)),
+		btnNegative:  l.Theme.OutlineButton(values.String(values.StrCancel)),
+		isCancelable: true,
+	}
+
+	cm.btnPositve.Font.Weight = text.Medium
+
+	cm.btnNegative.Font.Weight = text.Medium
+	cm.btnNegative.Margin = layout.Inset{Right: values.MarginPadding8}
+
+	cm.profileName = l.Theme.Editor(new(widget.Editor), values.String(values.StrProfileName))
+	cm.profileName.Editor.SingleLine, cm.profileName.Editor.Submit = true, true
+
+	cm.materialLoader = material.Loader(l.Theme.Base)
+
+	return cm
+}
+
+func (cm *CreateProfileModal) OnResume() {
+	cm.profileName.Editor.Focus()
+}
+
+func (cm *CreateProfileModal) OnDismiss() {}
+
+func (cm *CreateProfileModal) SetLoading(loading bool) {
+	cm.isLoading = loading
+	cm.Modal.SetDisabled(loading)
+}
+
+func (cm *CreateProfileModal) SetError(err string) {
+	cm.profileName.SetError(err)
+}
+
+func (cm *CreateProfileModal) ProfileCreated(callback func(name, network string, m *CreateProfileModal) bool) *CreateProfileModal {
+	cm.callback = callback
+	return cm
+}
+
+func (cm *CreateProfileModal) Handle() {
+	isEnabled := editorsNotEmpty(cm.profileName.Editor)
+	cm.btnPositve.SetEnabled(isEnabled)
+
+	isSubmit, isChanged := decredmaterial.HandleEditorEvents(cm.profileName.Editor)
+	if isChanged {
+		cm.profileName.SetError("")
+	}
+
+	if (cm.btnPositve.Clicked() || isSubmit) && isEnabled && !cm.isLoading {
+		cm.SetLoading(true)
+		if cm.callback(cm.profileName.Editor.Text(), cm.networkGroup.Value, cm) {
+			cm.Dismiss()
+		}
+	}
+
+	cm.btnNegative.SetEnabled(!cm.isLoading)
+	if cm.btnNegative.Clicked() {
+		if !cm.isLoading {
+			cm.Dismiss()
+		}
+	}
+
+	if cm.Modal.BackdropClicked(cm.isCancelable) {
+		if !cm.isLoading {
+			cm.Dismiss()
+		}
+	}
+}
+
+func (cm *CreateProfileModal) Layout(gtx layout.Context) D {
+	networks := make([]layout.FlexChild, 0, len(wallet.ProfileNetworks))
+	for _, network := range wallet.ProfileNetworks {
+		radio := cm.Theme.RadioButton(cm.networkGroup, network, NetworkName(network), cm.Theme.Color.DeepBlue, cm.Theme.Color.Primary)
+		networks = append(networks, layout.Rigid(radio.Layout))
+	}
+
+	w := []layout.Widget{
+		func(gtx C) D {
+			t := cm.Theme.H6(values.String(values.StrCreateProfile))
+			t.Font.Weight = text.SemiBold
+			return t.Layout(gtx)
+		},
+		cm.profileName.Layout,
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(cm.Theme.Label(values.TextSize14, values.String(values.StrNetwork)).Layout),
+				layout.Rigid(func(gtx C) D {
+					return layout.Flex{}.Layout(gtx, networks...)
+				}),
+			)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						if cm.isLoading {
+							return D{}
+						}
+
+						return cm.btnNegative.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx C) D {
+						if cm.isLoading {
+							return cm.materialLoader.Layout(gtx)
+						}
+						return cm.btnPositve.Layout(gtx)
+					}),
+				)
+			})
+		},
+	}
+
+	return cm.Modal.Layout(gtx, w)
+}
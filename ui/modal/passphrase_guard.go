@@ -0,0 +1,84 @@
+package modal
+
+import (
+	"strings"
+
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// seedRequired returns true if the user opted to require the wallet seed after
+// too many failed spending passphrase attempts and that limit is reached.
+func seedRequired(l *load.Load, scope string) bool {
+	return scope == wallet.PassphraseScopeSpending &&
+		l.WL.MultiWallet.ReadBoolConfigValueForKey(load.SeedAfterPassphraseFailuresKey, false) &&
+		l.WL.Wallet.PassphraseFailures(scope) >= wallet.SeedRequiredPassphraseFailures
+}
+
+// PassphraseAttemptError returns the reason the passphrase of scope cannot be
+// entered at this time, or an empty string if a new attempt is allowed.
+func PassphraseAttemptError(l *load.Load, scope string) string {
+	if scope == "" {
+		return ""
+	}
+
+	if seedRequired(l, scope) {
+		return values.String(values.StrSeedRequiredAfterFailures)
+	}
+
+	if delay := l.WL.Wallet.PassphraseRetryDelay(scope); delay > 0 {
+		return values.StringF(values.StrPassphraseRetryDelay, delay)
+	}
+	return ""
+}
+
+// PassphraseAttemptFailed records a failed attempt of the passphrase of scope
+// and returns the error to display to the user.
+func PassphraseAttemptFailed(l *load.Load, scope string) string {
+	delay := l.WL.Wallet.RecordPassphraseFailure(scope)
+	if seedRequired(l, scope) {
+		l.WL.Wallet.AddSecurityAuditEvent(scope, wallet.AuditEventSeedRequired)
+		return values.String(values.StrSeedRequiredAfterFailures)
+	}
+
+	if delay > 0 {
+		return values.StringF(values.StrInvalidPassphraseRetry, delay)
+	}
+	return values.String(values.StrInvalidPassphrase)
+}
+
+// ShowSeedRequiredModal asks for the seed of any loaded wallet if the seed is
+// required before the passphrase of scope may be entered again. It returns
+// false if the seed is not required.
+func ShowSeedRequiredModal(l *load.Load, window app.WindowNavigator, scope string) bool {
+	if !seedRequired(l, scope) {
+		return false
+	}
+
+	seedModal := NewTextInputModal(l).
+		Hint(values.String(values.StrEnterSeedPhrase)).
+		PositiveButtonStyle(l.Theme.Color.Primary, l.Theme.Color.InvText).
+		PositiveButton(values.String(values.StrConfirm), func(seed string, tm *TextInputModal) bool {
+			go func() {
+				seed = strings.Join(strings.Fields(seed), " ")
+				walletID, err := l.WL.MultiWallet.WalletWithSeed(seed)
+				if err != nil || walletID == -1 {
+					tm.SetError(values.String(values.StrInvalidSeedPhrase))
+					tm.SetLoading(false)
+					return
+				}
+
+				l.WL.Wallet.ResetPassphraseFailures(scope, wallet.AuditEventSeedVerified)
+				l.Toast.Notify(values.String(values.StrWalletSeedVerified))
+				tm.Dismiss()
+			}()
+			return false
+		})
+	seedModal.Title(values.String(values.StrVerifyWalletSeed)).
+		Body(values.String(values.StrVerifyWalletSeedInfo)).
+		NegativeButton(values.String(values.StrCancel), func() {})
+	window.ShowModal(seedModal)
+	return true
+}
@@ -6,9 +6,11 @@ import (
 	"gioui.org/widget"
 	"gioui.org/widget/material"
 
+	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type PasswordModal struct {
@@ -23,12 +25,20 @@ type PasswordModal struct {
 	isLoading    bool
 	isCancelable bool
 
+	// passphraseScope is the scope of failed attempts of the passphrase this
+	// modal asks for. Failed attempts are not tracked if it is empty.
+	passphraseScope string
+
 	customWidget layout.Widget
 
 	materialLoader material.LoaderStyle
 
+	// passphrase is the entered password passed to the positive button
+	// callback. It is zeroed when loading stops or the modal is dismissed.
+	passphrase []byte
+
 	positiveButtonText    string
-	positiveButtonClicked func(password string, m *PasswordModal) bool // return true to dismiss dialog
+	positiveButtonClicked func(password []byte, m *PasswordModal) bool // return true to dismiss dialog
 	btnPositve            decredmaterial.Button
 
 	negativeButtonText    string
@@ -43,6 +53,8 @@ func NewPasswordModal(l *load.Load) *PasswordModal {
 		btnPositve:   l.Theme.Button(values.String(values.StrConfirm)),
 		btnNegative:  l.Theme.OutlineButton(values.String(values.StrCancel)),
 		isCancelable: true,
+
+		passphraseScope: wallet.PassphraseScopeSpending,
 	}
 
 	pm.btnPositve.Font.Weight = text.Medium
@@ -63,7 +75,8 @@ func (pm *PasswordModal) OnResume() {
 }
 
 func (pm *PasswordModal) OnDismiss() {
-
+	wallet.ZeroBytes(pm.passphrase)
+	decredmaterial.WipeEditor(pm.password.Editor)
 }
 
 func (pm *PasswordModal) Title(title string) *PasswordModal {
@@ -81,12 +94,26 @@ func (pm *PasswordModal) UseCustomWidget(layout layout.Widget) *PasswordModal {
 	return pm
 }
 
+// PassphraseScope sets the scope used to limit failed attempts of the
+// passphrase. It defaults to the spending passphrase scope; an empty scope
+// disables the limit for passwords that are not wallet passphrases.
+func (pm *PasswordModal) PassphraseScope(scope string) *PasswordModal {
+	pm.passphraseScope = scope
+	return pm
+}
+
 func (pm *PasswordModal) Hint(hint string) *PasswordModal {
 	pm.password.Hint = hint
 	return pm
 }
 
-func (pm *PasswordModal) PositiveButton(text string, clicked func(password string, m *PasswordModal) bool) *PasswordModal {
+// PositiveButton sets the callback that receives the entered password. The
+// callback reports the outcome of the passphrase with Accepted or Failed
+// before dismissing the modal or when verification fails. The password is
+// zeroed once the callback dismisses the modal or calls
+// SetLoading(false), so callbacks that need it afterwards must copy it and
+// zero the copy with wallet.ZeroBytes when done.
+func (pm *PasswordModal) PositiveButton(text string, clicked func(password []byte, m *PasswordModal) bool) *PasswordModal {
 	pm.positiveButtonText = text
 	pm.positiveButtonClicked = clicked
 	return pm
@@ -101,6 +128,9 @@ func (pm *PasswordModal) NegativeButton(text string, clicked func()) *PasswordMo
 func (pm *PasswordModal) SetLoading(loading bool) {
 	pm.isLoading = loading
 	pm.Modal.SetDisabled(loading)
+	if !loading {
+		wallet.ZeroBytes(pm.passphrase)
+	}
 }
 
 func (pm *PasswordModal) SetCancelable(min bool) *PasswordModal {
@@ -108,6 +138,29 @@ func (pm *PasswordModal) SetCancelable(min bool) *PasswordModal {
 	return pm
 }
 
+// Accepted records that the positive button callback verified the entered
+// passphrase, which clears its failed attempts.
+func (pm *PasswordModal) Accepted() {
+	if pm.passphraseScope != "" {
+		pm.WL.Wallet.ResetPassphraseFailures(pm.passphraseScope, wallet.AuditEventPassphraseAccepted)
+	}
+}
+
+// Failed stops loading and displays err, the error the positive button
+// callback got for the entered passphrase. An invalid passphrase error is
+// recorded as a failed attempt of the passphrase.
+func (pm *PasswordModal) Failed(err error) {
+	errText := err.Error()
+	if errText == dcrlibwallet.ErrInvalidPassphrase {
+		errText = values.String(values.StrInvalidPassphrase)
+		if pm.passphraseScope != "" {
+			errText = PassphraseAttemptFailed(pm.Load, pm.passphraseScope)
+		}
+	}
+	pm.SetError(errText)
+	pm.SetLoading(false)
+}
+
 func (pm *PasswordModal) SetError(err string) {
 	if err == "" {
 		pm.password.ClearError()
@@ -133,9 +186,16 @@ func (pm *PasswordModal) Handle() {
 			return
 		}
 
+		if attemptErr := PassphraseAttemptError(pm.Load, pm.passphraseScope); attemptErr != "" {
+			pm.password.SetError(attemptErr)
+			ShowSeedRequiredModal(pm.Load, pm.ParentWindow(), pm.passphraseScope)
+			return
+		}
+
 		pm.SetLoading(true)
 		pm.SetError("")
-		if pm.positiveButtonClicked(pm.password.Editor.Text(), pm) {
+		pm.passphrase = decredmaterial.EditorBytes(pm.password.Editor)
+		if pm.positiveButtonClicked(pm.passphrase, pm) {
 			pm.Dismiss()
 		}
 	}
@@ -0,0 +1,162 @@
+package modal
+
+import (
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// ProxyModal edits the SOCKS5 proxy that the app's connections go through.
+type ProxyModal struct {
+	*load.Load
+	*decredmaterial.Modal
+
+	host     decredmaterial.Editor
+	username decredmaterial.Editor
+	password decredmaterial.Editor
+
+	streamIsolation decredmaterial.CheckBoxStyle
+	torOnly         decredmaterial.CheckBoxStyle
+
+	btnPositve  decredmaterial.Button
+	btnNegative decredmaterial.Button
+}
+
+func NewProxyModal(l *load.Load) *ProxyModal {
+	pm := &ProxyModal{
+		Load:            l,
+		Modal:           l.Theme.ModalFloatTitle("proxy_modal"),
+		streamIsolation: l.Theme.CheckBox(new(widget.Bool), values.String(values.StrStreamIsolationInfo)),
+		torOnly:         l.Theme.CheckBox(new(widget.Bool), values.String(values.StrTorOnlyInfo)),
+		btnPositve:      l.Theme.Button(values.String(values.StrSave)),
+		btnNegative:     l.Theme.OutlineButton(values.String(values.StrCancel)),
+	}
+
+	pm.btnPositve.Font.Weight = text.Medium
+
+	pm.btnNegative.Font.Weight = text.Medium
+	pm.btnNegative.Margin = layout.Inset{Right: values.MarginPadding8}
+
+	pm.host = l.Theme.Editor(new(widget.Editor), values.String(values.StrProxyHost))
+	pm.host.Editor.SingleLine = true
+	pm.username = l.Theme.Editor(new(widget.Editor), values.String(values.StrProxyUsername))
+	pm.username.Editor.SingleLine = true
+	pm.password = l.Theme.EditorPassword(new(widget.Editor), values.String(values.StrProxyPassword))
+	pm.password.Editor.SingleLine = true
+
+	return pm
+}
+
+func (pm *ProxyModal) OnResume() {
+	cfg := pm.WL.Wallet.ProxyConfig()
+	pm.host.Editor.SetText(cfg.Host)
+	pm.username.Editor.SetText(cfg.Username)
+	pm.password.Editor.SetText(cfg.Password)
+	pm.streamIsolation.CheckBox.Value = cfg.StreamIsolation
+	pm.torOnly.CheckBox.Value = cfg.TorOnly
+
+	overridden := pm.WL.Wallet.ProxyOverridden()
+	pm.btnPositve.SetEnabled(!overridden)
+	if !overridden {
+		pm.host.Editor.Focus()
+	}
+}
+
+func (pm *ProxyModal) OnDismiss() {
+	decredmaterial.WipeEditor(pm.password.Editor)
+}
+
+func (pm *ProxyModal) Handle() {
+	if _, isChanged := decredmaterial.HandleEditorEvents(pm.host.Editor); isChanged {
+		pm.host.SetError("")
+	}
+
+	if pm.btnPositve.Clicked() && !pm.WL.Wallet.ProxyOverridden() {
+		err := pm.WL.Wallet.SaveProxyConfig(wallet.ProxyConfig{
+			Host:            pm.host.Editor.Text(),
+			Username:        pm.username.Editor.Text(),
+			Password:        pm.password.Editor.Text(),
+			StreamIsolation: pm.streamIsolation.CheckBox.Value,
+			TorOnly:         pm.torOnly.CheckBox.Value,
+		})
+		if err != nil {
+			pm.host.SetError(err.Error())
+		} else {
+			pm.Toast.Notify(values.String(values.StrProxySaved))
+			pm.Dismiss()
+		}
+	}
+
+	if pm.btnNegative.Clicked() || pm.Modal.BackdropClicked(true) {
+		pm.Dismiss()
+	}
+}
+
+func (pm *ProxyModal) Layout(gtx layout.Context) D {
+	inset := layout.Inset{Top: values.MarginPadding10}
+	w := []layout.Widget{
+		func(gtx C) D {
+			t := pm.Theme.H6(values.String(values.StrProxy))
+			t.Font.Weight = text.SemiBold
+			return t.Layout(gtx)
+		},
+		func(gtx C) D {
+			if !pm.WL.Wallet.ProxyOverridden() {
+				return D{}
+			}
+			info := pm.Theme.Body2(values.String(values.StrProxySetInConfig))
+			info.Color = pm.Theme.Color.GrayText2
+			return info.Layout(gtx)
+		},
+		pm.host.Layout,
+		func(gtx C) D {
+			info := pm.Theme.Body2(values.String(values.StrProxyDirectConnections))
+			info.Color = pm.Theme.Color.GrayText2
+			return info.Layout(gtx)
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(pm.username.Layout),
+				layout.Rigid(func(gtx C) D {
+					return inset.Layout(gtx, pm.password.Layout)
+				}),
+			)
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(pm.Theme.Label(values.TextSize14, values.String(values.StrStreamIsolation)).Layout),
+				layout.Rigid(pm.streamIsolation.Layout),
+				layout.Rigid(func(gtx C) D {
+					return inset.Layout(gtx, pm.Theme.Label(values.TextSize14, values.String(values.StrTorOnly)).Layout)
+				}),
+				layout.Rigid(pm.torOnly.Layout),
+			)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(pm.btnNegative.Layout),
+					layout.Rigid(pm.btnPositve.Layout),
+				)
+			})
+		},
+	}
+
+	return pm.Modal.Layout(gtx, w)
+}
+
+// ProxyName returns the display name of the proxy in cfg.
+func ProxyName(cfg wallet.ProxyConfig) string {
+	if !cfg.Enabled() {
+		return values.String(values.StrProxyOff)
+	}
+	if cfg.TorOnly {
+		return cfg.Host + " (" + values.String(values.StrTorOnly) + ")"
+	}
+	return cfg.Host
+}
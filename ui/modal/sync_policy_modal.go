@@ -0,0 +1,196 @@
+package modal
+
+import (
+	"strconv"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// SyncPolicyModal edits when the wallets are allowed to sync.
+type SyncPolicyModal struct {
+	*load.Load
+	*decredmaterial.Modal
+
+	modeGroup *widget.Enum
+
+	startHour decredmaterial.Editor
+	endHour   decredmaterial.Editor
+
+	metered decredmaterial.CheckBoxStyle
+
+	btnPositve  decredmaterial.Button
+	btnNegative decredmaterial.Button
+}
+
+func NewSyncPolicyModal(l *load.Load) *SyncPolicyModal {
+	sm := &SyncPolicyModal{
+		Load:        l,
+		Modal:       l.Theme.ModalFloatTitle("sync_policy_modal"),
+		modeGroup:   new(widget.Enum),
+		metered:     l.Theme.CheckBox(new(widget.Bool), values.String(values.StrMeteredConnectionInfo)),
+		btnPositve:  l.Theme.Button(values.String(values.StrSave)),
+		btnNegative: l.Theme.OutlineButton(values.String(values.StrCancel)),
+	}
+
+	sm.btnPositve.Font.Weight = text.Medium
+
+	sm.btnNegative.Font.Weight = text.Medium
+	sm.btnNegative.Margin = layout.Inset{Right: values.MarginPadding8}
+
+	sm.startHour = l.Theme.Editor(new(widget.Editor), values.String(values.StrSyncStartHour))
+	sm.endHour = l.Theme.Editor(new(widget.Editor), values.String(values.StrSyncEndHour))
+	for _, e := range []*decredmaterial.Editor{&sm.startHour, &sm.endHour} {
+		e.Editor.SingleLine = true
+	}
+
+	return sm
+}
+
+func (sm *SyncPolicyModal) OnResume() {
+	p := sm.WL.Wallet.SyncPolicy()
+	sm.modeGroup.Value = strconv.Itoa(int(p.Mode))
+	sm.metered.CheckBox.Value = p.Metered
+
+	if p.Mode != wallet.SyncScheduled {
+		// Suggest a night window.
+		p.StartHour, p.EndHour = 1, 6
+	}
+	sm.startHour.Editor.SetText(strconv.Itoa(p.StartHour))
+	sm.endHour.Editor.SetText(strconv.Itoa(p.EndHour))
+}
+
+func (sm *SyncPolicyModal) OnDismiss() {}
+
+// hour returns the hour entered in e, or false after setting the error of e.
+func (sm *SyncPolicyModal) hour(e *decredmaterial.Editor) (int, bool) {
+	hour, err := strconv.Atoi(strings.TrimSpace(e.Editor.Text()))
+	if err != nil || hour < 0 || hour > 23 {
+		e.SetError(values.String(values.StrInvalidSyncHour))
+		return 0, false
+	}
+	return hour, true
+}
+
+func (sm *SyncPolicyModal) Handle() {
+	for _, e := range []*decredmaterial.Editor{&sm.startHour, &sm.endHour} {
+		if _, isChanged := decredmaterial.HandleEditorEvents(e.Editor); isChanged {
+			e.SetError("")
+		}
+	}
+
+	if sm.btnPositve.Clicked() {
+		mode, _ := strconv.Atoi(sm.modeGroup.Value)
+		p := wallet.SyncPolicy{
+			Mode:    wallet.SyncMode(mode),
+			Metered: sm.metered.CheckBox.Value,
+		}
+
+		valid := true
+		if p.Mode == wallet.SyncScheduled {
+			var startValid, endValid bool
+			p.StartHour, startValid = sm.hour(&sm.startHour)
+			p.EndHour, endValid = sm.hour(&sm.endHour)
+			valid = startValid && endValid
+		}
+
+		if valid {
+			if err := sm.WL.Wallet.SaveSyncPolicy(p); err != nil {
+				sm.endHour.SetError(err.Error())
+			} else {
+				sm.Toast.Notify(values.String(values.StrSyncPolicySaved))
+				sm.Dismiss()
+			}
+		}
+	}
+
+	if sm.btnNegative.Clicked() || sm.Modal.BackdropClicked(true) {
+		sm.Dismiss()
+	}
+}
+
+func (sm *SyncPolicyModal) Layout(gtx layout.Context) D {
+	modes := []wallet.SyncMode{wallet.SyncContinuous, wallet.SyncScheduled, wallet.SyncOnce}
+	modeButtons := make([]layout.FlexChild, 0, len(modes))
+	for _, mode := range modes {
+		name := SyncModeName(mode)
+		radio := sm.Theme.RadioButton(sm.modeGroup, strconv.Itoa(int(mode)), name, sm.Theme.Color.DeepBlue, sm.Theme.Color.Primary)
+		modeButtons = append(modeButtons, layout.Rigid(radio.Layout))
+	}
+
+	w := []layout.Widget{
+		func(gtx C) D {
+			t := sm.Theme.H6(values.String(values.StrSyncPolicy))
+			t.Font.Weight = text.SemiBold
+			return t.Layout(gtx)
+		},
+		func(gtx C) D {
+			info := sm.Theme.Body2(values.String(values.StrSyncPolicyInfo))
+			info.Color = sm.Theme.Color.GrayText2
+			return info.Layout(gtx)
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, modeButtons...)
+		},
+		func(gtx C) D {
+			if sm.modeGroup.Value != strconv.Itoa(int(wallet.SyncScheduled)) {
+				return D{}
+			}
+
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				layout.Flexed(0.5, func(gtx C) D {
+					return layout.Inset{Right: values.MarginPadding5}.Layout(gtx, sm.startHour.Layout)
+				}),
+				layout.Flexed(0.5, func(gtx C) D {
+					return layout.Inset{Left: values.MarginPadding5}.Layout(gtx, sm.endHour.Layout)
+				}),
+			)
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(sm.Theme.Label(values.TextSize14, values.String(values.StrMeteredConnection)).Layout),
+				layout.Rigid(sm.metered.Layout),
+			)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(sm.btnNegative.Layout),
+					layout.Rigid(sm.btnPositve.Layout),
+				)
+			})
+		},
+	}
+
+	return sm.Modal.Layout(gtx, w)
+}
+
+// SyncModeName returns the display name of mode.
+func SyncModeName(mode wallet.SyncMode) string {
+	switch mode {
+	case wallet.SyncScheduled:
+		return values.String(values.StrSyncScheduledMode)
+	case wallet.SyncOnce:
+		return values.String(values.StrSyncOnce)
+	}
+	return values.String(values.StrSyncContinuous)
+}
+
+// SyncPolicyName returns the display name of the sync policy p.
+func SyncPolicyName(p wallet.SyncPolicy) string {
+	name := SyncModeName(p.Mode)
+	if p.Mode == wallet.SyncScheduled {
+		name = values.StringF(values.StrSyncScheduled, p.StartHour, p.EndHour)
+	}
+	if p.Metered {
+		name += " (" + values.String(values.StrMeteredConnection) + ")"
+	}
+	return name
+}
@@ -1,11 +1,15 @@
 package modal
 
 import (
+	"math"
+
 	"gioui.org/layout"
 	"gioui.org/widget"
 
 	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type (
@@ -13,27 +17,109 @@ type (
 	D = layout.Dimensions
 )
 
+// NetworkName returns the display name of a dcrlibwallet network.
+func NetworkName(network string) string {
+	switch network {
+	case dcrlibwallet.Mainnet:
+		return values.String(values.StrMainnet)
+	case dcrlibwallet.Testnet3:
+		return values.String(values.StrTestnet)
+	}
+	return network
+}
+
 func editorsNotEmpty(editors ...*widget.Editor) bool {
 	for _, e := range editors {
-		if e.Text() == "" {
+		if e.Len() == 0 {
 			return false
 		}
 	}
 	return true
 }
 
-func computePasswordStrength(pb *decredmaterial.ProgressBarStyle, th *decredmaterial.Theme, editors ...*widget.Editor) {
-	password := editors[0]
-	strength := dcrlibwallet.ShannonEntropy(password.Text()) / 4.0
-	pb.Progress = float32(strength)
+var passwordWarnings = map[wallet.PasswordWarning]string{
+	wallet.PasswordWarningCommon:               values.StrPasswordWarningCommon,
+	wallet.PasswordWarningSimilarToCommon:      values.StrPasswordWarningSimilarToCommon,
+	wallet.PasswordWarningWordByItself:         values.StrPasswordWarningWordByItself,
+	wallet.PasswordWarningUserInput:            values.StrPasswordWarningUserInput,
+	wallet.PasswordWarningStraightRow:          values.StrPasswordWarningStraightRow,
+	wallet.PasswordWarningShortKeyboardPattern: values.StrPasswordWarningKeyboardPattern,
+	wallet.PasswordWarningRepeat:               values.StrPasswordWarningRepeat,
+	wallet.PasswordWarningSequence:             values.StrPasswordWarningSequence,
+	wallet.PasswordWarningDate:                 values.StrPasswordWarningDate,
+	wallet.PasswordWarningRecentYear:           values.StrPasswordWarningRecentYear,
+}
+
+var passwordTips = map[wallet.PasswordSuggestion]string{
+	wallet.PasswordSuggestionAddWords:              values.StrPasswordTipAddWords,
+	wallet.PasswordSuggestionAvoidCapitalization:   values.StrPasswordTipCapitalization,
+	wallet.PasswordSuggestionAvoidReversed:         values.StrPasswordTipReversed,
+	wallet.PasswordSuggestionAvoidSubstitutions:    values.StrPasswordTipSubstitutions,
+	wallet.PasswordSuggestionLongerKeyboardPattern: values.StrPasswordTipKeyboardPattern,
+	wallet.PasswordSuggestionAvoidRepeats:          values.StrPasswordTipRepeats,
+	wallet.PasswordSuggestionAvoidSequences:        values.StrPasswordTipSequences,
+	wallet.PasswordSuggestionAvoidDates:            values.StrPasswordTipDates,
+}
+
+// computePasswordStrength estimates the strength of the password in editor and
+// shows it on pb. userInputs are words an attacker may know, such as the
+// wallet name.
+func computePasswordStrength(pb *decredmaterial.ProgressBarStyle, th *decredmaterial.Theme, editor *widget.Editor, userInputs ...string) wallet.PasswordStrength {
+	password := decredmaterial.EditorBytes(editor)
+	strength := wallet.EstimatePasswordStrength(password, userInputs...)
+	wallet.ZeroBytes(password)
+
+	pb.Progress = 0
+	if editor.Len() > 0 {
+		pb.Progress = float32(strength.Score+1) / float32(wallet.MaxPasswordScore+1)
+	}
 
 	//set progress bar color
 	switch {
-	case pb.Progress <= 0.30:
+	case strength.Score <= 1:
 		pb.Color = th.Color.Danger
-	case pb.Progress > 0.30 && pb.Progress <= 0.60:
+	case strength.Score == 2:
 		pb.Color = th.Color.Yellow
-	case pb.Progress > 0.50:
+	default:
 		pb.Color = th.Color.Success
 	}
+	return strength
+}
+
+// crackTimeText returns a rounded description of a duration in seconds.
+func crackTimeText(seconds float64) string {
+	const (
+		minute  = 60
+		hour    = minute * 60
+		day     = hour * 24
+		month   = day * 31
+		year    = month * 12
+		century = year * 100
+	)
+
+	count := func(unit float64, one, many string) string {
+		n := int(math.Round(seconds / unit))
+		if n <= 1 {
+			return values.String(one)
+		}
+		return values.StringF(many, n)
+	}
+
+	switch {
+	case seconds < 1:
+		return values.String(values.StrLessThanASecond)
+	case seconds < minute:
+		return count(1, values.StrOneSecond, values.StrSecondsCount)
+	case seconds < hour:
+		return count(minute, values.StrOneMinute, values.StrMinutesCount)
+	case seconds < day:
+		return count(hour, values.StrOneHour, values.StrHoursCount)
+	case seconds < month:
+		return count(day, values.StrOneDay, values.StrDaysCount)
+	case seconds < year:
+		return count(month, values.StrOneMonth, values.StrMonthsCount)
+	case seconds < century:
+		return count(year, values.StrOneYear, values.StrYearsCount)
+	}
+	return values.String(values.StrCenturies)
 }
@@ -0,0 +1,166 @@
+package page
+
+import (
+	"sync/atomic"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/modal"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const AppLockPageID = "app_lock"
+
+// AppLockPage covers the whole window after a period of inactivity until the
+// startup password is entered. Wallets stay open while the app is locked so
+// that sync and account mixing continue in the background.
+type AppLockPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	passwordEditor decredmaterial.Editor
+	unlockButton   decredmaterial.Button
+
+	// isUnlocking is 1 while the startup password is verified. It is
+	// cleared by the unlock goroutine, so it is accessed atomically.
+	isUnlocking int32
+	// onUnlocked is called from the unlock goroutine after the startup
+	// password is verified.
+	onUnlocked func()
+}
+
+func NewAppLockPage(l *load.Load, onUnlocked func()) *AppLockPage {
+	pg := &AppLockPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(AppLockPageID),
+		unlockButton:     l.Theme.Button(values.String(values.StrUnlock)),
+		onUnlocked:       onUnlocked,
+	}
+
+	pg.passwordEditor = l.Theme.EditorPassword(new(widget.Editor), values.String(values.StrStartupPassword))
+	pg.passwordEditor.Editor.SingleLine, pg.passwordEditor.Editor.Submit = true, true
+	pg.unlockButton.Font.Weight = text.Medium
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *AppLockPage) OnNavigatedTo() {
+	decredmaterial.WipeEditor(pg.passwordEditor.Editor)
+	pg.passwordEditor.Editor.Focus()
+}
+
+func (pg *AppLockPage) unlock() {
+	if pg.passwordEditor.Editor.Len() == 0 || atomic.LoadInt32(&pg.isUnlocking) == 1 {
+		return
+	}
+
+	if attemptErr := modal.PassphraseAttemptError(pg.Load, wallet.PassphraseScopeStartup); attemptErr != "" {
+		pg.passwordEditor.SetError(attemptErr)
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&pg.isUnlocking, 0, 1) {
+		return
+	}
+	password := decredmaterial.EditorBytes(pg.passwordEditor.Editor)
+	go func() {
+		defer func() {
+			wallet.ZeroBytes(password)
+			atomic.StoreInt32(&pg.isUnlocking, 0)
+		}()
+
+		err := pg.WL.MultiWallet.VerifyStartupPassphrase(password)
+		if err != nil {
+			if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
+				pg.passwordEditor.SetError(modal.PassphraseAttemptFailed(pg.Load, wallet.PassphraseScopeStartup))
+			} else {
+				pg.passwordEditor.SetError(translateErr(err))
+			}
+			pg.ParentWindow().Reload()
+			return
+		}
+
+		pg.WL.Wallet.ResetPassphraseFailures(wallet.PassphraseScopeStartup, wallet.AuditEventPassphraseAccepted)
+		decredmaterial.WipeEditor(pg.passwordEditor.Editor)
+		if pg.onUnlocked != nil {
+			pg.onUnlocked()
+		}
+		pg.ParentNavigator().CloseCurrentPage()
+	}()
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *AppLockPage) HandleUserInteractions() {
+	isSubmit, isChanged := decredmaterial.HandleEditorEvents(pg.passwordEditor.Editor)
+	if isChanged {
+		pg.passwordEditor.SetError("")
+	}
+
+	if pg.unlockButton.Clicked() || isSubmit {
+		pg.unlock()
+	}
+
+	pg.unlockButton.SetEnabled(pg.passwordEditor.Editor.Len() > 0 && atomic.LoadInt32(&pg.isUnlocking) == 0)
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *AppLockPage) OnNavigatedFrom() {}
+
+// Layout draws the page UI components into the provided C
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *AppLockPage) Layout(gtx C) D {
+	gtx.Constraints.Min = gtx.Constraints.Max // use maximum height & width
+	return layout.Center.Layout(gtx, func(gtx C) D {
+		gtx.Constraints.Max.X = gtx.Dp(values.MarginPadding350)
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		return layout.Flex{Alignment: layout.Middle, Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				return pg.Theme.Icons.DecredLogo.LayoutSize(gtx, values.MarginPadding150)
+			}),
+			layout.Rigid(func(gtx C) D {
+				title := pg.Theme.Label(values.TextSize20, values.String(values.StrAppLocked))
+				title.Font.Weight = text.Medium
+				return layout.Inset{Top: values.MarginPadding14}.Layout(gtx, title.Layout)
+			}),
+			layout.Rigid(func(gtx C) D {
+				info := pg.Theme.Body1(values.String(values.StrAppLockedInfo))
+				info.Color = pg.Theme.Color.GrayText2
+				info.Alignment = text.Middle
+				return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, info.Layout)
+			}),
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding24}.Layout(gtx, pg.passwordEditor.Layout)
+			}),
+			layout.Rigid(func(gtx C) D {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.unlockButton.Layout)
+			}),
+		)
+	})
+}
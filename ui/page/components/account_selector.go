@@ -18,11 +18,8 @@ import (
 	"github.com/planetdecred/godcr/ui/values"
 )
 
-const AccoutSelectorID = "AccountSelector"
-
 type AccountSelector struct {
 	*load.Load
-	*listeners.TxAndBlockNotificationListener
 
 	selectedAccount *dcrlibwallet.Account
 	accountIsValid  func(*dcrlibwallet.Account) bool
@@ -160,7 +157,7 @@ func (as *AccountSelector) Layout(window app.WindowNavigator, gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
 				return layout.Flex{}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						return as.Theme.Body1(as.totalBalance).Layout(gtx)
+						return as.Theme.Body1(as.MaskAmount(as.totalBalance)).Layout(gtx)
 					}),
 					layout.Rigid(func(gtx C) D {
 						inset := layout.Inset{
@@ -179,20 +176,13 @@ func (as *AccountSelector) Layout(window app.WindowNavigator, gtx C) D {
 }
 
 func (as *AccountSelector) ListenForTxNotifications(ctx context.Context, window app.WindowNavigator) {
-	if as.TxAndBlockNotificationListener != nil {
-		return
-	}
-	as.TxAndBlockNotificationListener = listeners.NewTxAndBlockNotificationListener()
-	err := as.WL.MultiWallet.AddTxAndBlockNotificationListener(as.TxAndBlockNotificationListener, true, AccoutSelectorID)
-	if err != nil {
-		log.Errorf("Error adding tx and block notification listener: %v", err)
-		return
-	}
+	sub := as.Bus.Subscribe(ctx, listeners.DropOldest, listeners.TxAndBlockTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-as.TxAndBlockNotifChan:
+		defer as.RecoverPanic()
+
+		for event := range sub.Events() {
+			if n, ok := event.(listeners.TxNotification); ok {
 				switch n.Type {
 				case listeners.BlockAttached:
 					// refresh wallet account and balance on every new block
@@ -212,11 +202,6 @@ func (as *AccountSelector) ListenForTxNotifications(ctx context.Context, window
 					}
 					window.Reload()
 				}
-			case <-ctx.Done():
-				as.WL.MultiWallet.RemoveTxAndBlockNotificationListener(AccoutSelectorID)
-				close(as.TxAndBlockNotifChan)
-				as.TxAndBlockNotificationListener = nil
-				return
 			}
 		}
 	}()
@@ -399,7 +384,7 @@ func (asm *AccountSelectorModal) walletAccountLayout(gtx C, account *selectorAcc
 				layout.Rigid(func(gtx C) D {
 					spendable := asm.Theme.Label(values.TextSize14, values.String(values.StrLabelSpendable))
 					spendable.Color = asm.Theme.Color.GrayText2
-					spendableBal := asm.Theme.Label(values.TextSize14, dcrutil.Amount(account.Balance.Spendable).String())
+					spendableBal := asm.Theme.Label(values.TextSize14, asm.MaskAmount(dcrutil.Amount(account.Balance.Spendable).String()))
 					spendableBal.Color = asm.Theme.Color.GrayText2
 					return EndToEndRow(gtx, spendable.Layout, spendableBal.Layout)
 				}),
@@ -20,6 +20,11 @@ var (
 )
 
 func formatBalance(gtx layout.Context, l *load.Load, amount string, mainTextSize unit.Sp, scale float32, col color.NRGBA, withUnit bool) D {
+	if l.IsPrivacyModeOn() {
+		txt := l.Theme.Label(mainTextSize, l.MaskAmount(amount))
+		txt.Color = col
+		return txt.Layout(gtx)
+	}
 
 	startIndex := 0
 
@@ -452,12 +452,12 @@ func LayoutTransactionRow(gtx layout.Context, l *load.Load, row TransactionRow)
 							// mix denomination or ticket price
 							if row.Transaction.Type == dcrlibwallet.TxTypeMixed {
 								mixedDenom := dcrutil.Amount(row.Transaction.MixDenomination).String()
-								txt := l.Theme.Label(values.TextSize12, mixedDenom)
+								txt := l.Theme.Label(values.TextSize12, l.MaskAmount(mixedDenom))
 								txt.Color = l.Theme.Color.GrayText2
 								return txt.Layout(gtx)
 							} else if wal.TxMatchesFilter(&row.Transaction, dcrlibwallet.TxFilterStaking) {
 								ticketPrice := dcrutil.Amount(row.Transaction.Amount).String()
-								txt := l.Theme.Label(values.TextSize12, ticketPrice)
+								txt := l.Theme.Label(values.TextSize12, l.MaskAmount(ticketPrice))
 								txt.Color = l.Theme.Color.GrayText2
 								return txt.Layout(gtx)
 							}
@@ -494,7 +494,7 @@ func LayoutTransactionRow(gtx layout.Context, l *load.Load, row TransactionRow)
 									}.Layout(gtx, ic.Layout16dp)
 								}),
 								layout.Rigid(func(gtx C) D {
-									label := l.Theme.Label(values.TextSize12, dcrutil.Amount(row.Transaction.VoteReward).String())
+									label := l.Theme.Label(values.TextSize12, l.MaskAmount(dcrutil.Amount(row.Transaction.VoteReward).String()))
 									label.Color = l.Theme.Color.Orange
 									if row.Transaction.Type == dcrlibwallet.TxTypeVote {
 										label.Color = l.Theme.Color.Turquoise800
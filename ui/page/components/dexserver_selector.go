@@ -51,14 +51,14 @@ func (ds *DexServerSelector) Expose() {
 // isLoadingDexClient check for Dexc start, initialized, loggedin status,
 // since Dex client UI not required for app password, IsInitialized and IsLoggedIn should be done at dcrlibwallet.
 func (ds *DexServerSelector) isLoadingDexClient() bool {
-	return ds.Dexc().Core() == nil || !ds.Dexc().Core().IsInitialized() || !ds.Dexc().IsLoggedIn()
+	return ds.Dexc().Core() == nil || !ds.Dexc().Core().IsInitialized() || ds.WL.Wallet.DexLoginRequired(ds.Dexc())
 }
 
 // startDexClient do start DEX client,
 // initialize and login to DEX,
 // since Dex client UI not required for app password, initialize and login should be done at dcrlibwallet.
 func (ds *DexServerSelector) startDexClient() {
-	_, err := ds.WL.MultiWallet.StartDexClient()
+	_, err := ds.WL.Wallet.StartDexClient()
 	if err != nil {
 		ds.Toast.NotifyError(err.Error())
 		return
@@ -73,8 +73,8 @@ func (ds *DexServerSelector) startDexClient() {
 		}
 	}
 
-	if !ds.Dexc().IsLoggedIn() {
-		err := ds.Dexc().Login([]byte(values.DEXClientPass))
+	if ds.WL.Wallet.DexLoginRequired(ds.Dexc()) {
+		err := ds.WL.Wallet.DexLogin(ds.Dexc(), []byte(values.DEXClientPass))
 		if err != nil {
 			ds.Toast.NotifyError(err.Error())
 			return
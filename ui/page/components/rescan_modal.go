@@ -0,0 +1,242 @@
+package components
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/values"
+)
+
+const (
+	rescanFromHeight = "height"
+	rescanFromDate   = "date"
+
+	rescanDateLayout = "2006-01-02"
+)
+
+// RescanModal rescans the blocks of one or more wallets from a height or a
+// date chosen by the user.
+type RescanModal struct {
+	*load.Load
+	*decredmaterial.Modal
+
+	fromGroup *widget.Enum
+	height    decredmaterial.Editor
+	date      decredmaterial.Editor
+
+	wallets   []*dcrlibwallet.Wallet
+	checkBoxs []decredmaterial.CheckBoxStyle
+
+	btnPositve  decredmaterial.Button
+	btnNegative decredmaterial.Button
+}
+
+// NewRescanModal returns a rescan dialog with the wallet with walletID
+// selected.
+func NewRescanModal(l *load.Load, walletID int) *RescanModal {
+	rm := &RescanModal{
+		Load:        l,
+		Modal:       l.Theme.ModalFloatTitle("rescan_modal"),
+		fromGroup:   &widget.Enum{Value: rescanFromHeight},
+		btnPositve:  l.Theme.Button(values.String(values.StrRescan)),
+		btnNegative: l.Theme.OutlineButton(values.String(values.StrCancel)),
+	}
+
+	rm.btnPositve.Font.Weight = text.Medium
+
+	rm.btnNegative.Font.Weight = text.Medium
+	rm.btnNegative.Margin = layout.Inset{Right: values.MarginPadding8}
+
+	rm.height = l.Theme.Editor(new(widget.Editor), values.String(values.StrRescanStartHeight))
+	rm.height.Editor.SingleLine = true
+	rm.height.Editor.SetText("0")
+	rm.date = l.Theme.Editor(new(widget.Editor), values.String(values.StrRescanStartDate))
+	rm.date.Editor.SingleLine = true
+
+	rm.wallets = l.WL.SortedWalletList()
+	for _, wal := range rm.wallets {
+		cb := l.Theme.CheckBox(new(widget.Bool), wal.Name)
+		cb.CheckBox.Value = wal.ID == walletID
+		rm.checkBoxs = append(rm.checkBoxs, cb)
+	}
+
+	return rm
+}
+
+func (rm *RescanModal) OnResume() {}
+
+func (rm *RescanModal) OnDismiss() {}
+
+// startHeight returns the height entered or converted from the date entered.
+// It returns false if neither is valid.
+func (rm *RescanModal) startHeight() (int32, bool) {
+	if rm.fromGroup.Value == rescanFromDate {
+		date, err := time.ParseInLocation(rescanDateLayout, strings.TrimSpace(rm.date.Editor.Text()), time.Local)
+		if err != nil || date.After(time.Now()) {
+			return 0, false
+		}
+		return rm.WL.Wallet.HeightAtTime(date), true
+	}
+
+	height, err := strconv.ParseInt(strings.TrimSpace(rm.height.Editor.Text()), 10, 32)
+	if err != nil || height < 0 || int32(height) > rm.WL.MultiWallet.GetBestBlock().Height {
+		return 0, false
+	}
+	return int32(height), true
+}
+
+func (rm *RescanModal) selectedWallets() []int {
+	var ids []int
+	for i, cb := range rm.checkBoxs {
+		if cb.CheckBox.Value {
+			ids = append(ids, rm.wallets[i].ID)
+		}
+	}
+	return ids
+}
+
+func (rm *RescanModal) Handle() {
+	for _, e := range []*decredmaterial.Editor{&rm.height, &rm.date} {
+		if _, isChanged := decredmaterial.HandleEditorEvents(e.Editor); isChanged {
+			e.SetError("")
+		}
+	}
+
+	if rm.btnPositve.Clicked() {
+		rm.rescan()
+	}
+
+	if rm.btnNegative.Clicked() || rm.Modal.BackdropClicked(true) {
+		rm.Dismiss()
+	}
+}
+
+func (rm *RescanModal) rescan() {
+	height, ok := rm.startHeight()
+	if !ok {
+		if rm.fromGroup.Value == rescanFromDate {
+			rm.date.SetError(values.String(values.StrInvalidRescanDate))
+		} else {
+			rm.height.SetError(values.StringF(values.StrInvalidRescanHeight, rm.WL.MultiWallet.GetBestBlock().Height))
+		}
+		return
+	}
+
+	walletIDs := rm.selectedWallets()
+	if len(walletIDs) == 0 {
+		rm.Toast.NotifyError(values.String(values.StrSelectWalletToRescan))
+		return
+	}
+
+	err := rm.WL.Wallet.RescanFromHeight(walletIDs, height)
+	if err != nil {
+		if err.Error() == dcrlibwallet.ErrNotConnected {
+			rm.Toast.NotifyError(values.String(values.StrNotConnected))
+		} else {
+			rm.Toast.NotifyError(err.Error())
+		}
+		return
+	}
+
+	rm.Toast.Notify(values.String(values.StrRescanProgressNotification))
+	rm.Dismiss()
+}
+
+// estimate returns the start height and estimated duration of the rescan
+// with the values entered.
+func (rm *RescanModal) estimate() (string, string) {
+	height, ok := rm.startHeight()
+	if !ok {
+		return "", values.String(values.StrRescanTimeUnknown)
+	}
+
+	startsAt := ""
+	if rm.fromGroup.Value == rescanFromDate {
+		startsAt = values.StringF(values.StrRescanStartsAt, height)
+	}
+
+	wallets := len(rm.selectedWallets())
+	duration, ok := rm.WL.Wallet.EstimateRescanTime(height, wallets)
+	if !ok || wallets == 0 {
+		return startsAt, values.String(values.StrRescanTimeUnknown)
+	}
+	return startsAt, TimeFormat(int(duration.Seconds()), true)
+}
+
+func (rm *RescanModal) Layout(gtx layout.Context) D {
+	startsAt, estimate := rm.estimate()
+
+	w := []layout.Widget{
+		func(gtx C) D {
+			t := rm.Theme.H6(values.String(values.StrRescanBlockchain))
+			t.Font.Weight = text.SemiBold
+			return t.Layout(gtx)
+		},
+		func(gtx C) D {
+			info := rm.Theme.Body2(values.String(values.StrRescanInfo))
+			info.Color = rm.Theme.Color.GrayText2
+			return info.Layout(gtx)
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(rm.Theme.Label(values.TextSize14, values.String(values.StrRescanFrom)).Layout),
+				layout.Rigid(func(gtx C) D {
+					return layout.Flex{}.Layout(gtx,
+						layout.Rigid(rm.Theme.RadioButton(rm.fromGroup, rescanFromHeight, values.String(values.StrRescanFromHeight), rm.Theme.Color.DeepBlue, rm.Theme.Color.Primary).Layout),
+						layout.Rigid(rm.Theme.RadioButton(rm.fromGroup, rescanFromDate, values.String(values.StrRescanFromDate), rm.Theme.Color.DeepBlue, rm.Theme.Color.Primary).Layout),
+					)
+				}),
+			)
+		},
+		func(gtx C) D {
+			if rm.fromGroup.Value == rescanFromDate {
+				return rm.date.Layout(gtx)
+			}
+			return rm.height.Layout(gtx)
+		},
+		func(gtx C) D {
+			children := []layout.FlexChild{
+				layout.Rigid(rm.Theme.Label(values.TextSize14, values.String(values.StrWalletsToRescan)).Layout),
+			}
+			for i := range rm.checkBoxs {
+				children = append(children, layout.Rigid(rm.checkBoxs[i].Layout))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		},
+		func(gtx C) D {
+			title := rm.Theme.Body2(values.String(values.StrEstimatedRescanTime))
+			title.Color = rm.Theme.Color.GrayText2
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					return EndToEndRow(gtx, title.Layout, rm.Theme.Body2(estimate).Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					if startsAt == "" {
+						return D{}
+					}
+					hint := rm.Theme.Caption(startsAt)
+					hint.Color = rm.Theme.Color.GrayText2
+					return hint.Layout(gtx)
+				}),
+			)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(rm.btnNegative.Layout),
+					layout.Rigid(rm.btnPositve.Layout),
+				)
+			})
+		},
+	}
+
+	return rm.Modal.Layout(gtx, w)
+}
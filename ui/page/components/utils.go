@@ -4,11 +4,14 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 // done returns whether the context's Done channel was closed due to
@@ -38,9 +41,37 @@ func RetryFunc(retryAttempts int, sleepDur time.Duration, funcDesc string, errFu
 		if err == nil {
 			return i, nil
 		}
+		if errors.Is(err, wallet.ErrOffline) {
+			// Retrying cannot succeed until the settings change.
+			return i + 1, err
+		}
 	}
 
-	return retryAttempts, fmt.Errorf("last error: %s", err)
+	return retryAttempts, fmt.Errorf("last error: %w", err)
+}
+
+// NetworkErrorText returns a message for the user that explains a network
+// feature or request error.
+func NetworkErrorText(err error) string {
+	switch {
+	case errors.Is(err, wallet.ErrOffline):
+		return values.String(values.StrOfflineModeOn)
+	case errors.Is(err, wallet.ErrDirectConnection):
+		return values.String(values.StrUnavailableTorOnly)
+	case errors.Is(err, wallet.ErrTimeout):
+		return values.String(values.StrRequestTimedOut)
+	case errors.Is(err, wallet.ErrConnection):
+		return values.String(values.StrServerUnreachable)
+	case errors.Is(err, wallet.ErrRateLimited):
+		return values.String(values.StrTooManyRequests)
+	case errors.Is(err, wallet.ErrServerResponse):
+		return values.String(values.StrBadServerResponse)
+	case errors.Is(err, wallet.ErrMeteredConnection):
+		return values.String(values.StrSyncPausedMetered)
+	case errors.Is(err, wallet.ErrOutsideSyncHours):
+		return values.String(values.StrOutsideSyncHours)
+	}
+	return err.Error()
 }
 
 func SeedWordsToHex(seedWords string) (string, error) {
@@ -95,3 +126,26 @@ func checksumByte(data []byte) byte {
 	intermediateHash := sha256.Sum256(data)
 	return sha256.Sum256(intermediateHash[:])[0]
 }
+
+// TaskName returns the display name of a background task of kind.
+func TaskName(kind wallet.TaskKind) string {
+	switch kind {
+	case wallet.TaskSync:
+		return values.String(values.StrTaskSync)
+	case wallet.TaskRescan:
+		return values.String(values.StrTaskRescan)
+	case wallet.TaskAccountMixer:
+		return values.String(values.StrTaskAccountMixer)
+	case wallet.TaskTicketBuyer:
+		return values.String(values.StrTaskTicketBuyer)
+	case wallet.TaskPoliteiaSync:
+		return values.String(values.StrTaskPoliteiaSync)
+	case wallet.TaskDexStart:
+		return values.String(values.StrTaskDexStart)
+	case wallet.TaskExchangeRate:
+		return values.String(values.StrTaskExchangeRate)
+	case wallet.TaskBroadcast:
+		return values.String(values.StrTaskBroadcast)
+	}
+	return fmt.Sprint(kind)
+}
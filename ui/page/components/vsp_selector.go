@@ -14,6 +14,7 @@ import (
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type VSPSelector struct {
@@ -152,7 +153,7 @@ func newVSPSelectorModal(l *load.Load) *vspSelectorModal {
 }
 
 func (v *vspSelectorModal) OnResume() {
-	if len(v.WL.MultiWallet.KnownVSPs()) == 0 {
+	if len(v.WL.MultiWallet.KnownVSPs()) == 0 && v.WL.Wallet.FeatureError(wallet.FeatureVSP) == nil {
 		go func() {
 			v.WL.MultiWallet.ReloadVSPList(context.TODO())
 			v.ParentWindow().Reload()
@@ -164,9 +165,9 @@ func (v *vspSelectorModal) Handle() {
 	v.addVSP.SetEnabled(v.editorsNotEmpty(v.inputVSP.Editor))
 	if v.addVSP.Clicked() {
 		go func() {
-			err := v.WL.MultiWallet.SaveVSP(v.inputVSP.Editor.Text())
+			err := v.WL.Wallet.SaveVSP(v.inputVSP.Editor.Text())
 			if err != nil {
-				v.Toast.NotifyError(err.Error())
+				v.Toast.NotifyError(NetworkErrorText(err))
 			} else {
 				v.inputVSP.Editor.SetText("")
 			}
@@ -31,7 +31,6 @@ type WalletSelector struct {
 	// and the root WindowNavigator.
 	*app.GenericPageModal
 
-	*listeners.TxAndBlockNotificationListener
 	ctx context.Context
 
 	listLock sync.Mutex
@@ -355,20 +354,13 @@ func (ws *WalletSelector) walletWrapper(gtx C, item *load.WalletItem, isWatching
 }
 
 func (ws *WalletSelector) listenForTxNotifications() {
-	if ws.TxAndBlockNotificationListener != nil {
-		return
-	}
-	ws.TxAndBlockNotificationListener = listeners.NewTxAndBlockNotificationListener()
-	err := ws.WL.MultiWallet.AddTxAndBlockNotificationListener(ws.TxAndBlockNotificationListener, true, WalletSelectorID)
-	if err != nil {
-		// log.Errorf("Error adding tx and block notification listener: %v", err)
-		return
-	}
+	sub := ws.Bus.Subscribe(ws.ctx, listeners.DropOldest, listeners.TxAndBlockTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-ws.TxAndBlockNotifChan:
+		defer ws.RecoverPanic()
+
+		for event := range sub.Events() {
+			if n, ok := event.(listeners.TxNotification); ok {
 				switch n.Type {
 				case listeners.BlockAttached:
 					// refresh wallet account and balance on every new block
@@ -382,11 +374,6 @@ func (ws *WalletSelector) listenForTxNotifications() {
 					ws.updateAccountBalance()
 					ws.ParentWindow().Reload()
 				}
-			case <-ws.ctx.Done():
-				ws.WL.MultiWallet.RemoveTxAndBlockNotificationListener(WalletSelectorID)
-				close(ws.TxAndBlockNotifChan)
-				ws.TxAndBlockNotificationListener = nil
-				return
 			}
 		}
 	}()
@@ -441,10 +428,10 @@ func (ws *WalletSelector) startSyncing() {
 		}
 	}
 
-	err := ws.WL.MultiWallet.SpvSync()
+	err := ws.WL.Wallet.SpvSync()
 	if err != nil {
-		// show error dialog
 		log.Info("Error starting sync:", err)
+		ws.Toast.NotifyError(NetworkErrorText(err))
 	}
 }
 
@@ -453,18 +440,14 @@ func (ws *WalletSelector) UnlockWalletForSyncing(wal *dcrlibwallet.Wallet) {
 		Title(values.String(values.StrResumeAccountDiscoveryTitle)).
 		Hint(values.String(values.StrSpendingPassword)).
 		NegativeButton(values.String(values.StrCancel), func() {}).
-		PositiveButton(values.String(values.StrUnlock), func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton(values.String(values.StrUnlock), func(password []byte, pm *modal.PasswordModal) bool {
 			go func() {
-				err := ws.WL.MultiWallet.UnlockWallet(wal.ID, []byte(password))
+				err := ws.WL.MultiWallet.UnlockWallet(wal.ID, password)
 				if err != nil {
-					errText := err.Error()
-					if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
-						errText = values.String(values.StrInvalidPassphrase)
-					}
-					pm.SetError(errText)
-					pm.SetLoading(false)
+					pm.Failed(err)
 					return
 				}
+				pm.Accepted()
 				pm.Dismiss()
 				ws.startSyncing()
 			}()
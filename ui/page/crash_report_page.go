@@ -0,0 +1,226 @@
+package page
+
+import (
+	"strings"
+
+	"gioui.org/io/clipboard"
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/modal"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const CrashReportPageID = "CrashReport"
+
+// CrashReportPage lists the saved crash reports and copies or saves the
+// selected one.
+type CrashReportPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	scrollbarList *widget.List
+	backButton    decredmaterial.IconButton
+
+	reports       []*wallet.CrashReport
+	selected      int
+	reportButtons []*decredmaterial.Clickable
+
+	copyReport decredmaterial.Button
+	saveReport decredmaterial.Button
+
+	// copyPending is set when the selected report is to be copied on the
+	// next frame.
+	copyPending bool
+}
+
+func NewCrashReportPage(l *load.Load) *CrashReportPage {
+	pg := &CrashReportPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(CrashReportPageID),
+		scrollbarList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		copyReport: l.Theme.OutlineButton(values.String(values.StrCopy)),
+		saveReport: l.Theme.Button(values.String(values.StrSave)),
+	}
+	pg.copyReport.TextSize = values.TextSize14
+	pg.saveReport.TextSize = values.TextSize14
+
+	pg.backButton, _ = components.SubpageHeaderButtons(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *CrashReportPage) OnNavigatedTo() {
+	reports, err := pg.WL.Wallet.CrashReports()
+	if err != nil {
+		log.Errorf("Unable to read the crash reports: %v", err)
+		pg.Toast.NotifyError(err.Error())
+	}
+	pg.reports = reports
+	pg.selected = 0
+	pg.reportButtons = make([]*decredmaterial.Clickable, len(reports))
+	for i := range pg.reportButtons {
+		pg.reportButtons[i] = pg.Theme.NewClickable(true)
+	}
+
+	if err = pg.WL.Wallet.MarkCrashReportsSeen(); err != nil {
+		log.Errorf("Unable to mark the crash reports seen: %v", err)
+	}
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *CrashReportPage) HandleUserInteractions() {
+	for i, clickable := range pg.reportButtons {
+		for clickable.Clicked() {
+			pg.selected = i
+		}
+	}
+
+	if pg.selected >= len(pg.reports) {
+		return
+	}
+
+	for pg.copyReport.Clicked() {
+		pg.copyPending = true
+	}
+
+	for pg.saveReport.Clicked() {
+		path, err := pg.WL.Wallet.ExportCrashReport(pg.reports[pg.selected])
+		if err != nil {
+			pg.Toast.NotifyError(err.Error())
+			continue
+		}
+		info := modal.NewInfoModal(pg.Load).
+			Title(values.String(values.StrCrashReportSaved)).
+			Body(values.StringF(values.StrCrashReportSavedTo, path)).
+			PositiveButton(values.String(values.StrGotIt), func(isChecked bool) bool {
+				return true
+			})
+		pg.ParentWindow().ShowModal(info)
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *CrashReportPage) OnNavigatedFrom() {}
+
+// Layout draws the page UI components into the provided layout context
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *CrashReportPage) Layout(gtx C) D {
+	if pg.copyPending && pg.selected < len(pg.reports) {
+		clipboard.WriteOp{Text: pg.reports[pg.selected].Text()}.Add(gtx.Ops)
+		pg.Toast.Notify(values.String(values.StrCopied))
+	}
+	pg.copyPending = false
+
+	container := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrCrashReports),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: pg.layoutReports,
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView) {
+		return components.UniformMobile(gtx, false, true, container)
+	}
+	return components.UniformPadding(gtx, container)
+}
+
+func (pg *CrashReportPage) layoutReports(gtx C) D {
+	if len(pg.reports) == 0 {
+		return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			lbl := pg.Theme.Body1(values.String(values.StrNoCrashReports))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.UniformInset(values.MarginPadding16).Layout(gtx, lbl.Layout)
+		})
+	}
+
+	return pg.Theme.List(pg.scrollbarList).Layout(gtx, len(pg.reports), func(gtx C, i int) D {
+		return layout.Inset{Right: values.MarginPadding2, Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+			return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return layout.UniformInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
+					return pg.reportSection(gtx, i)
+				})
+			})
+		})
+	})
+}
+
+func (pg *CrashReportPage) reportSection(gtx C, i int) D {
+	report := pg.reports[i]
+	header := func(gtx C) D {
+		return pg.reportButtons[i].Layout(gtx, func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					title := pg.Theme.Body1(report.Time.Format("2006-01-02 15:04:05"))
+					title.Font.Weight = text.SemiBold
+					return title.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx C) D {
+					// The first line of the panic is enough to tell
+					// the reports apart.
+					panicLine := strings.SplitN(report.Panic, "\n", 2)[0]
+					lbl := pg.Theme.Body2(panicLine)
+					lbl.Color = pg.Theme.Color.GrayText2
+					lbl.MaxLines = 1
+					return lbl.Layout(gtx)
+				}),
+			)
+		})
+	}
+	if i != pg.selected {
+		return header(gtx)
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(header),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10, Bottom: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return pg.Theme.Caption(report.Text()).Layout(gtx)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{}.Layout(gtx,
+					layout.Rigid(pg.copyReport.Layout),
+					layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+					layout.Rigid(pg.saveReport.Layout),
+				)
+			})
+		}),
+	)
+}
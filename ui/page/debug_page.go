@@ -49,6 +49,27 @@ func NewDebugPage(l *load.Load) *DebugPage {
 				parentPage.Display(NewStatPage(l))
 			},
 		},
+		{
+			text: values.String(values.StrNetworkAndPeers),
+			page: NetworkPageID,
+			action: func(parentPage app.PageNavigator) {
+				parentPage.Display(NewNetworkPage(l))
+			},
+		},
+		{
+			text: values.String(values.StrCrashReports),
+			page: CrashReportPageID,
+			action: func(parentPage app.PageNavigator) {
+				parentPage.Display(NewCrashReportPage(l))
+			},
+		},
+		{
+			text: values.String(values.StrDiagnosticsBundle),
+			page: DiagnosticsPageID,
+			action: func(parentPage app.PageNavigator) {
+				parentPage.Display(NewDiagnosticsPage(l))
+			},
+		},
 	}
 
 	pg := &DebugPage{
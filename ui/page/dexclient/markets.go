@@ -160,9 +160,9 @@ func (pg *Page) OnNavigatedFrom() {
 // Part of the load.Page interface.
 func (pg *Page) HandleUserInteractions() {
 	if pg.syncBtn.Button.Clicked() {
-		err := pg.WL.MultiWallet.SpvSync()
+		err := pg.WL.Wallet.SpvSync()
 		if err != nil {
-			pg.Toast.NotifyError(err.Error())
+			pg.Toast.NotifyError(components.NetworkErrorText(err))
 		}
 	}
 
@@ -177,16 +177,16 @@ func (pg *Page) HandleUserInteractions() {
 // isLoadingDexClient check for Dexc start, initialized, loggedin status,
 // since Dex client UI not required for app password, IsInitialized and IsLoggedIn should be done at dcrlibwallet.
 func (pg *Page) isLoadingDexClient() bool {
-	return pg.Dexc().Core() == nil || !pg.Dexc().Core().IsInitialized() || !pg.Dexc().IsLoggedIn()
+	return pg.Dexc().Core() == nil || !pg.Dexc().Core().IsInitialized() || pg.WL.Wallet.DexLoginRequired(pg.Dexc())
 }
 
 // startDexClient do start DEX client,
 // initialize and login to DEX,
 // since Dex client UI not required for app password, initialize and login should be done at dcrlibwallet.
 func (pg *Page) startDexClient() {
-	_, err := pg.WL.MultiWallet.StartDexClient()
+	_, err := pg.WL.Wallet.StartDexClient()
 	if err != nil {
-		pg.Toast.NotifyError(err.Error())
+		pg.Toast.NotifyError(components.NetworkErrorText(err))
 		return
 	}
 
@@ -199,8 +199,8 @@ func (pg *Page) startDexClient() {
 		}
 	}
 
-	if !pg.Dexc().IsLoggedIn() {
-		err := pg.Dexc().Login([]byte(DEXClientPass))
+	if pg.WL.Wallet.DexLoginRequired(pg.Dexc()) {
+		err := pg.WL.Wallet.DexLogin(pg.Dexc(), []byte(DEXClientPass))
 		if err != nil {
 			pg.Toast.NotifyError(err.Error())
 			return
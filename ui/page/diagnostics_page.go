@@ -0,0 +1,188 @@
+package page
+
+import (
+	"fmt"
+
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/modal"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const DiagnosticsPageID = "Diagnostics"
+
+// DiagnosticsPage lists the files of a diagnostics bundle and writes the
+// bundle when the user confirms.
+type DiagnosticsPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	scrollbarList *widget.List
+	backButton    decredmaterial.IconButton
+
+	bundle       *wallet.DiagnosticsBundle
+	createBundle decredmaterial.Button
+}
+
+func NewDiagnosticsPage(l *load.Load) *DiagnosticsPage {
+	pg := &DiagnosticsPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(DiagnosticsPageID),
+		scrollbarList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		createBundle: l.Theme.Button(values.String(values.StrCreateBundle)),
+	}
+	pg.createBundle.TextSize = values.TextSize14
+
+	pg.backButton, _ = components.SubpageHeaderButtons(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *DiagnosticsPage) OnNavigatedTo() {
+	stats := NewStatPage(pg.Load)
+	stats.OnNavigatedTo()
+
+	bundle, err := pg.WL.Wallet.NewDiagnosticsBundle(stats.stats())
+	if err != nil {
+		log.Errorf("Unable to list the diagnostics bundle files: %v", err)
+		pg.Toast.NotifyError(err.Error())
+	}
+	pg.bundle = bundle
+	pg.createBundle.SetEnabled(bundle != nil)
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *DiagnosticsPage) HandleUserInteractions() {
+	for pg.createBundle.Clicked() {
+		if pg.bundle == nil {
+			continue
+		}
+		path, err := pg.WL.Wallet.SaveDiagnosticsBundle(pg.bundle)
+		if err != nil {
+			pg.Toast.NotifyError(err.Error())
+			continue
+		}
+		info := modal.NewInfoModal(pg.Load).
+			Title(values.String(values.StrDiagnosticsBundleSaved)).
+			Body(values.StringF(values.StrDiagnosticsBundleSavedTo, path)).
+			PositiveButton(values.String(values.StrGotIt), func(isChecked bool) bool {
+				return true
+			})
+		pg.ParentWindow().ShowModal(info)
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *DiagnosticsPage) OnNavigatedFrom() {}
+
+// Layout draws the page UI components into the provided layout context
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *DiagnosticsPage) Layout(gtx C) D {
+	container := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrDiagnosticsBundle),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: pg.layoutFiles,
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView) {
+		return components.UniformMobile(gtx, false, true, container)
+	}
+	return components.UniformPadding(gtx, container)
+}
+
+func (pg *DiagnosticsPage) layoutFiles(gtx C) D {
+	var files []wallet.DiagnosticsFile
+	if pg.bundle != nil {
+		files = pg.bundle.Files
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrDiagnosticsBundleInfo))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, lbl.Layout)
+		}),
+		layout.Flexed(1, func(gtx C) D {
+			return pg.Theme.List(pg.scrollbarList).Layout(gtx, 1, func(gtx C, _ int) D {
+				return layout.Inset{Right: values.MarginPadding2}.Layout(gtx, func(gtx C) D {
+					return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+						gtx.Constraints.Min.X = gtx.Constraints.Max.X
+						return layout.UniformInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
+							return pg.fileRows(gtx, files)
+						})
+					})
+				})
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				return layout.E.Layout(gtx, pg.createBundle.Layout)
+			})
+		}),
+	)
+}
+
+func (pg *DiagnosticsPage) fileRows(gtx C, files []wallet.DiagnosticsFile) D {
+	rows := make([]layout.FlexChild, len(files))
+	for i, file := range files {
+		file := file
+		rows[i] = layout.Rigid(func(gtx C) D {
+			name := pg.Theme.Body2(file.Name)
+			size := pg.Theme.Body2(formatFileSize(file.Size))
+			size.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding4, Bottom: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, name.Layout, size.Layout)
+			})
+		})
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+}
+
+// formatFileSize returns size in bytes in the largest unit it is at least one
+// of.
+func formatFileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGT"[exp])
+}
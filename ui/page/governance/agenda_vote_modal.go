@@ -12,6 +12,7 @@ import (
 	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
 )
@@ -32,7 +33,6 @@ type agendaVoteModal struct {
 
 	walletSelector    *WalletSelector
 	ticketSelector    *ticketSelector
-	spendingPassword  decredmaterial.Editor
 	materialLoader    material.LoaderStyle
 	voteChoices       []string
 	initialValue      string
@@ -49,7 +49,6 @@ func newAgendaVoteModal(l *load.Load, agenda *dcrlibwallet.Agenda, onPreferenceU
 		onPreferenceUpdated: onPreferenceUpdated,
 		materialLoader:      material.Loader(material.NewTheme(gofont.Collection())),
 		optionsRadioGroup:   new(widget.Enum),
-		spendingPassword:    l.Theme.EditorPassword(new(widget.Editor), values.String(values.StrSpendingPassword)),
 		voteBtn:             l.Theme.Button(values.String(values.StrUpdatePreference)),
 		cancelBtn:           l.Theme.OutlineButton(values.String(values.StrCancel)),
 	}
@@ -127,11 +126,6 @@ func (avm *agendaVoteModal) Handle() {
 		avm.Dismiss()
 	}
 
-	_, isChanged := decredmaterial.HandleEditorEvents(avm.spendingPassword.Editor)
-	if isChanged {
-		avm.spendingPassword.SetError("")
-	}
-
 	if len(avm.votableTickets) != 0 {
 		if avm.modalUpdateCount == 1 { // modal window has been updated once.
 			avm.modalUpdateCount++
@@ -139,7 +133,7 @@ func (avm *agendaVoteModal) Handle() {
 		}
 	}
 
-	validToVote := avm.optionsRadioGroup.Value != "" && avm.optionsRadioGroup.Value != avm.initialValue && avm.spendingPassword.Editor.Text() != ""
+	validToVote := avm.optionsRadioGroup.Value != "" && avm.optionsRadioGroup.Value != avm.initialValue
 	avm.voteBtn.SetEnabled(validToVote)
 	if avm.voteBtn.Enabled() {
 		avm.voteBtn.Background = avm.Theme.Color.Primary
@@ -183,11 +177,6 @@ func (avm *agendaVoteModal) Layout(gtx layout.Context) D {
 				}),
 			)
 		},
-		func(gtx C) D {
-			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-				layout.Rigid(avm.spendingPassword.Layout),
-			)
-		},
 		func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
 				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
@@ -221,26 +210,31 @@ func (avm *agendaVoteModal) layoutItems() []layout.FlexChild {
 }
 
 func (avm *agendaVoteModal) sendVotes() {
-	go func() {
-		password := []byte(avm.spendingPassword.Editor.Text())
+	selectedWallet := avm.walletSelector.selectedWallet
+	choiceID := avm.optionsRadioGroup.Value
 
-		defer func() {
+	passwordModal := modal.NewPasswordModal(avm.Load).
+		Title(values.String(values.StrUpdatevotePref)).
+		NegativeButton(values.String(values.StrCancel), func() {
 			avm.isVoting = false
-		}()
+		}).
+		PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
+			go func() {
+				err := selectedWallet.SetVoteChoice(avm.agenda.AgendaID, choiceID, "", password)
+				if err != nil {
+					pm.Failed(err)
+					return
+				}
+				pm.Accepted()
+				pm.Dismiss()
+				avm.isVoting = false
+				avm.Toast.Notify(values.String(values.StrVoteUpdated))
 
-		choiceID := avm.optionsRadioGroup.Value
-		err := avm.walletSelector.selectedWallet.SetVoteChoice(avm.agenda.AgendaID, choiceID, "", password)
-		if err != nil {
-			if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
-				avm.spendingPassword.SetError(values.String(values.StrInvalidPassphrase))
-			} else {
-				avm.Toast.NotifyError(err.Error())
-			}
-			return
-		}
-		avm.Toast.Notify(values.String(values.StrVoteUpdated))
+				avm.Dismiss()
+				avm.onPreferenceUpdated()
+			}()
 
-		avm.Dismiss()
-		avm.onPreferenceUpdated()
-	}()
+			return false
+		})
+	avm.ParentWindow().ShowModal(passwordModal)
 }
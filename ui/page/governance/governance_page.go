@@ -14,6 +14,7 @@ import (
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 const GovernancePageID = "Governance"
@@ -63,7 +64,7 @@ func (pg *Page) OnNavigatedTo() {
 }
 
 func (pg *Page) isGovernanceFeatureEnabled() bool {
-	return pg.WL.MultiWallet.ReadBoolConfigValueForKey(load.FetchProposalConfigKey, false)
+	return pg.WL.MultiWallet.ReadBoolConfigValueForKey(load.FetchProposalConfigKey, false) && pg.WL.Wallet.FeatureError(wallet.FeatureGovernance) == nil
 }
 
 // OnNavigatedFrom is called when the page is about to be removed from
@@ -89,7 +90,11 @@ func (pg *Page) HandleUserInteractions() {
 	}
 
 	for pg.enableGovernanceBtn.Clicked() {
-		go pg.WL.MultiWallet.Politeia.Sync()
+		if err := pg.WL.Wallet.FeatureError(wallet.FeatureGovernance); err != nil {
+			pg.Toast.NotifyError(components.NetworkErrorText(err))
+			continue
+		}
+		go pg.WL.Wallet.SyncPoliteia()
 		pg.Display(NewProposalsPage(pg.Load))
 		pg.WL.MultiWallet.SaveUserConfigValue(load.FetchProposalConfigKey, true)
 	}
@@ -39,8 +39,6 @@ type ProposalDetails struct {
 	// and the root WindowNavigator.
 	*app.GenericPageModal
 
-	*listeners.ProposalNotificationListener //not needed.
-
 	ctx       context.Context // page context
 	ctxCancel context.CancelFunc
 
@@ -187,34 +185,19 @@ func (pg *ProposalDetails) HandleUserInteractions() {
 }
 
 func (pg *ProposalDetails) listenForSyncNotifications() {
-	if pg.ProposalNotificationListener == nil {
-		return
-	}
-	pg.ProposalNotificationListener = listeners.NewProposalNotificationListener()
-	err := pg.WL.MultiWallet.Politeia.AddNotificationListener(pg.ProposalNotificationListener, ProposalDetailsPageID)
-	if err != nil {
-		log.Errorf("Error adding politeia notification listener: %v", err)
-		return
-	}
+	sub := pg.Bus.Subscribe(pg.ctx, listeners.DropOldest, listeners.ProposalTopic)
 
 	go func() {
-		for {
-			select {
-			case notification := <-pg.ProposalNotifChan:
-				if notification.ProposalStatus == wallet.Synced {
-					proposal, err := pg.WL.MultiWallet.Politeia.GetProposalRaw(pg.proposal.Token)
-					if err == nil {
-						pg.proposal = proposal
-						pg.ParentWindow().Reload()
-					}
+		defer pg.RecoverPanic()
+
+		for event := range sub.Events() {
+			notification, ok := event.(wallet.Proposal)
+			if ok && notification.ProposalStatus == wallet.Synced {
+				proposal, err := pg.WL.MultiWallet.Politeia.GetProposalRaw(pg.proposal.Token)
+				if err == nil {
+					pg.proposal = proposal
+					pg.ParentWindow().Reload()
 				}
-			// is this really needed since listener has been set up on main.go
-			case <-pg.ctx.Done():
-				pg.WL.MultiWallet.Politeia.RemoveNotificationListener(ProposalDetailsPageID)
-				close(pg.ProposalNotifChan)
-				pg.ProposalNotificationListener = nil
-
-				return
 			}
 		}
 	}()
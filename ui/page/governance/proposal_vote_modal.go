@@ -152,17 +152,17 @@ func (vm *voteModal) sendVotes() {
 		NegativeButton(values.String(values.StrCancel), func() {
 			vm.isVoting = false
 		}).
-		PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 			go func() {
-				err := vm.WL.MultiWallet.Politeia.CastVotes(vm.walletSelector.selectedWallet.ID, votes, vm.proposal.Token, password)
+				err := vm.WL.MultiWallet.Politeia.CastVotes(vm.walletSelector.selectedWallet.ID, votes, vm.proposal.Token, string(password))
 				if err != nil {
-					pm.SetError(err.Error())
-					pm.SetLoading(false)
+					pm.Failed(err)
 					return
 				}
+				pm.Accepted()
 				pm.Dismiss()
 				vm.Toast.Notify(values.String(values.StrVoteSent))
-				go vm.WL.MultiWallet.Politeia.Sync()
+				go vm.WL.Wallet.SyncPoliteia()
 				vm.Dismiss()
 			}()
 
@@ -35,7 +35,6 @@ type ProposalsPage struct {
 	// and the root WindowNavigator.
 	*app.GenericPageModal
 
-	*listeners.ProposalNotificationListener
 	ctx        context.Context // page context
 	ctxCancel  context.CancelFunc
 	proposalMu sync.Mutex
@@ -172,7 +171,11 @@ func (pg *ProposalsPage) HandleUserInteractions() {
 	}
 
 	for pg.syncButton.Clicked() {
-		go pg.multiWallet.Politeia.Sync()
+		if err := pg.WL.Wallet.FeatureError(wallet.FeatureGovernance); err != nil {
+			pg.Toast.NotifyError(components.NetworkErrorText(err))
+			continue
+		}
+		go pg.WL.Wallet.SyncPoliteia()
 		pg.isSyncing = true
 
 		//Todo: check after 1min if sync does not start, set isSyncing to false and cancel sync
@@ -411,33 +414,19 @@ func (pg *ProposalsPage) layoutSectionHeader(gtx C) D {
 }
 
 func (pg *ProposalsPage) listenForSyncNotifications() {
-	if pg.ProposalNotificationListener != nil {
-		return
-	}
-	pg.ProposalNotificationListener = listeners.NewProposalNotificationListener()
-	err := pg.WL.MultiWallet.Politeia.AddNotificationListener(pg.ProposalNotificationListener, ProposalsPageID)
-	if err != nil {
-		log.Errorf("Error adding politeia notification listener: %v", err)
-		return
-	}
+	sub := pg.Bus.Subscribe(pg.ctx, listeners.DropOldest, listeners.ProposalTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-pg.ProposalNotifChan:
-				if n.ProposalStatus == wallet.Synced {
-					pg.syncCompleted = true
-					pg.isSyncing = false
-
-					pg.fetchProposals()
-					pg.ParentWindow().Reload()
-				}
-			case <-pg.ctx.Done():
-				pg.WL.MultiWallet.Politeia.RemoveNotificationListener(ProposalsPageID)
-				close(pg.ProposalNotifChan)
-				pg.ProposalNotificationListener = nil
-
-				return
+		defer pg.RecoverPanic()
+
+		for event := range sub.Events() {
+			n, ok := event.(wallet.Proposal)
+			if ok && n.ProposalStatus == wallet.Synced {
+				pg.syncCompleted = true
+				pg.isSyncing = false
+
+				pg.fetchProposals()
+				pg.ParentWindow().Reload()
 			}
 		}
 	}()
@@ -267,20 +267,21 @@ func (pg *TreasuryPage) updatePolicyPreference(treasuryItem *components.Treasury
 	passwordModal := modal.NewPasswordModal(pg.Load).
 		Title(values.String(values.StrConfirmVote)).
 		NegativeButton(values.String(values.StrCancel), func() {}).
-		PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 			go func() {
 				selectedWallet := pg.WL.SelectedWallet.Wallet
 				votingPreference := treasuryItem.OptionsRadioGroup.Value
-				err := selectedWallet.SetTreasuryPolicy(treasuryItem.Policy.PiKey, votingPreference, "", []byte(password))
+				err := selectedWallet.SetTreasuryPolicy(treasuryItem.Policy.PiKey, votingPreference, "", password)
 				if err != nil {
 					if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
-						pm.SetError(values.String(values.StrInvalidPassphrase))
+						pm.Failed(err)
 					} else {
 						pm.Toast.NotifyError(err.Error())
+						pm.SetLoading(false)
 					}
-					pm.SetLoading(false)
 					return
 				}
+				pm.Accepted()
 				go pg.FetchPolicies() // re-fetch policies when voting is done.
 				pm.Toast.Notify(values.String(values.StrPolicySetSuccessful))
 				pm.Dismiss()
@@ -129,7 +129,7 @@ func (as *WalletSelector) Layout(gtx layout.Context, window app.WindowNavigator)
 					layout.Flexed(1, func(gtx C) D {
 						return layout.E.Layout(gtx, func(gtx C) D {
 							return layout.Flex{}.Layout(gtx,
-								layout.Rigid(as.Theme.Body1(as.totalBalance).Layout),
+								layout.Rigid(as.Theme.Body1(as.MaskAmount(as.totalBalance)).Layout),
 								layout.Rigid(func(gtx C) D {
 									inset := layout.Inset{
 										Left: values.MarginPadding15,
@@ -289,7 +289,7 @@ func (asm *WalletSelectorModal) walletAccountLayout(gtx layout.Context, wallet *
 								spendable := asm.Theme.Label(values.TextSize14, values.String(values.StrLabelSpendable))
 								spendable.Color = asm.Theme.Color.GrayText2
 								//TODO
-								spendableBal := asm.Theme.Label(values.TextSize14, walletSpendableBalance.String())
+								spendableBal := asm.Theme.Label(values.TextSize14, asm.MaskAmount(walletSpendableBalance.String()))
 								spendableBal.Color = asm.Theme.Color.GrayText2
 								return components.EndToEndRow(gtx, spendable.Layout, spendableBal.Layout)
 							}),
@@ -44,9 +44,6 @@ type WalletInfo struct {
 	// and the root WindowNavigator.
 	*app.GenericPageModal
 
-	*listeners.SyncProgressListener
-	*listeners.BlocksRescanProgressListener
-	*listeners.TxAndBlockNotificationListener
 	ctx       context.Context // page context
 	ctxCancel context.CancelFunc
 	listLock  sync.Mutex
@@ -58,6 +55,7 @@ type WalletInfo struct {
 
 	walletStatusIcon *decredmaterial.Icon
 	syncSwitch       *decredmaterial.Switch
+	restartSync      decredmaterial.Button
 	toBackup         decredmaterial.Button
 	checkBox         decredmaterial.CheckBoxStyle
 
@@ -162,7 +160,7 @@ func (pg *WalletInfo) Layout(gtx layout.Context) layout.Dimensions {
 func (pg *WalletInfo) HandleUserInteractions() {
 	if pg.syncSwitch.Changed() {
 		if pg.WL.MultiWallet.IsRescanning() {
-			pg.WL.MultiWallet.CancelRescan()
+			pg.WL.Wallet.CancelRescan()
 		} else {
 			pg.WL.MultiWallet.SaveUserConfigValue(load.AutoSyncConfigKey, pg.syncSwitch.IsChecked())
 			go func() {
@@ -171,6 +169,8 @@ func (pg *WalletInfo) HandleUserInteractions() {
 		}
 	}
 
+	pg.handleRestartSync()
+
 	for pg.toBackup.Button.Clicked() {
 		pg.ParentNavigator().Display(seedbackup.NewBackupInstructionsPage(pg.Load, pg.WL.SelectedWallet.Wallet))
 	}
@@ -183,36 +183,15 @@ func (pg *WalletInfo) HandleUserInteractions() {
 // refreshes the display every set interval. Other sync updates that affect
 // the UI but occur outside of an active sync requires a display refresh.
 func (pg *WalletInfo) listenForNotifications() {
-	switch {
-	case pg.SyncProgressListener != nil:
-		return
-	case pg.TxAndBlockNotificationListener != nil:
-		return
-	case pg.BlocksRescanProgressListener != nil:
-		return
-	}
-
-	pg.SyncProgressListener = listeners.NewSyncProgress()
-	err := pg.WL.MultiWallet.AddSyncProgressListener(pg.SyncProgressListener, InfoID)
-	if err != nil {
-		log.Errorf("Error adding sync progress listener: %v", err)
-		return
-	}
-
-	pg.TxAndBlockNotificationListener = listeners.NewTxAndBlockNotificationListener()
-	err = pg.WL.MultiWallet.AddTxAndBlockNotificationListener(pg.TxAndBlockNotificationListener, true, InfoID)
-	if err != nil {
-		log.Errorf("Error adding tx and block notification listener: %v", err)
-		return
-	}
-
-	pg.BlocksRescanProgressListener = listeners.NewBlocksRescanProgressListener()
-	pg.WL.MultiWallet.SetBlocksRescanProgressListener(pg.BlocksRescanProgressListener)
+	sub := pg.Bus.Subscribe(pg.ctx, listeners.Coalesce,
+		listeners.SyncTopic, listeners.TxAndBlockTopic, listeners.RescanTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-pg.SyncStatusChan:
+		defer pg.RecoverPanic()
+
+		for event := range sub.Events() {
+			switch n := event.(type) {
+			case wallet.SyncStatusUpdate:
 				// Update sync progress fields which will be displayed
 				// when the next UI invalidation occurs.
 				switch t := n.ProgressReport.(type) {
@@ -243,35 +222,23 @@ func (pg *WalletInfo) listenForNotifications() {
 				case wallet.SyncCanceled:
 					fallthrough
 				case wallet.SyncCompleted:
+					fallthrough
+				case wallet.SyncEndedWithError:
 					pg.ParentWindow().Reload()
 				}
 
-			case n := <-pg.TxAndBlockNotifChan:
+			case listeners.TxNotification:
 				switch n.Type {
 				case listeners.NewTransaction:
 					pg.ParentWindow().Reload()
 				case listeners.BlockAttached:
 					pg.ParentWindow().Reload()
 				}
-			case n := <-pg.BlockRescanChan:
+			case wallet.RescanUpdate:
 				pg.rescanUpdate = &n
 				if n.Stage == wallet.RescanEnded {
 					pg.ParentWindow().Reload()
 				}
-			case <-pg.ctx.Done():
-				pg.WL.MultiWallet.RemoveSyncProgressListener(InfoID)
-				pg.WL.MultiWallet.RemoveTxAndBlockNotificationListener(InfoID)
-				pg.WL.MultiWallet.SetBlocksRescanProgressListener(nil)
-
-				close(pg.SyncStatusChan)
-				close(pg.TxAndBlockNotifChan)
-				close(pg.BlockRescanChan)
-
-				pg.SyncProgressListener = nil
-				pg.TxAndBlockNotificationListener = nil
-				pg.BlocksRescanProgressListener = nil
-
-				return
 			}
 		}
 	}()
@@ -19,7 +19,7 @@ import (
 
 const CreateRestorePageID = "Restore"
 
-var tabTitles = []string{"Seed Words", "Hex"}
+var tabTitles = []string{"Seed Words", "Hex", "Seed Shares"}
 
 type Restore struct {
 	*load.Load
@@ -134,10 +134,16 @@ func (pg *Restore) indexLayout(gtx C) D {
 }
 
 func (pg *Restore) switchTab(tabIndex int) {
-	if tabIndex == 0 {
+	switch tabIndex {
+	case 0:
 		pg.seedRestorePage.OnNavigatedTo()
-	} else {
+	case 1:
 		pg.showHexRestoreModal()
+	case 2:
+		// The recovered seed is filled into the seed words tab to be
+		// validated and restored as usual.
+		pg.tabIndex = 0
+		pg.ParentNavigator().Display(NewSeedSharesRestorePage(pg.Load, pg.seedRestorePage.setSeedWords))
 	}
 }
 
@@ -239,9 +245,9 @@ func (pg *Restore) showHexRestoreModal() {
 						pg.tabIndex = 0
 						pg.switchTab(pg.tabIndex)
 					}).
-					PasswordCreated(func(walletName, password string, m *modal.CreatePasswordModal) bool {
+					PasswordCreated(func(walletName string, password []byte, m *modal.CreatePasswordModal) bool {
 						go func() {
-							_, err := pg.WL.MultiWallet.RestoreWallet(walletName, hex, password, dcrlibwallet.PassphraseTypePass)
+							_, err := pg.WL.MultiWallet.RestoreWallet(walletName, hex, string(password), dcrlibwallet.PassphraseTypePass)
 							if err != nil {
 								m.SetError(components.TranslateErr(err))
 								m.SetLoading(false)
@@ -18,6 +18,7 @@ import (
 	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 const (
@@ -60,6 +61,10 @@ type SeedRestore struct {
 
 	seedPhrase string
 
+	seedCorrections       []wallet.SeedCorrection
+	seedCorrectionButtons []decredmaterial.Button
+	seedCorrectionsModal  *modal.InfoModal
+
 	openPopupIndex  int
 	selected        int
 	suggestionLimit int
@@ -369,6 +374,10 @@ func (pg *SeedRestore) editorSeedsEventsHandler() {
 		for _, e := range editor.Edit.Editor.Events() {
 			switch e.(type) {
 			case widget.ChangeEvent:
+				if words := wallet.ParseSeedInput(text); len(words) > 1 {
+					pg.pasteSeedWords(i, words)
+					text = editor.Edit.Editor.Text()
+				}
 				seedEvent(i, text)
 
 			case widget.SubmitEvent:
@@ -443,13 +452,19 @@ func (pg *SeedRestore) layoutSeedMenu(gtx C, optionsSeedMenuIndex int) {
 	op.Defer(gtx.Ops, m.Stop())
 }
 
-func (pg SeedRestore) suggestionSeeds(text string) []string {
+// suggestionSeeds returns the words starting with text that may be used at the
+// seed position. Seed words alternate between the even and odd halves of the
+// PGP word list, so only words from the half of the position are suggested.
+func (pg SeedRestore) suggestionSeeds(text string, position int) []string {
 	var seeds []string
 	if text == "" {
 		return seeds
 	}
 
-	for _, word := range pg.allSuggestions {
+	for i, word := range pg.allSuggestions {
+		if i%2 != position%2 {
+			continue
+		}
 		if strings.HasPrefix(strings.ToLower(word), strings.ToLower(text)) {
 			if len(seeds) < pg.suggestionLimit {
 				seeds = append(seeds, word)
@@ -496,7 +511,7 @@ func (pg *SeedRestore) verifySeeds() bool {
 	if isValid {
 		pg.seedPhrase = seedphrase
 		if !dcrlibwallet.VerifySeed(pg.seedPhrase) {
-			pg.Toast.NotifyError(values.String(values.StrInvalidSeedPhrase))
+			pg.diagnoseSeed(strings.Fields(pg.seedPhrase))
 			return false
 		}
 	}
@@ -517,9 +532,130 @@ func (pg *SeedRestore) verifySeeds() bool {
 	return true
 }
 
+// setSeedWords fills the seed editors with the words of seed.
+func (pg *SeedRestore) setSeedWords(seed string) {
+	words := strings.Fields(seed)
+	for i := range pg.seedEditors.editors {
+		word := ""
+		if i < len(words) {
+			word = words[i]
+		}
+		pg.seedEditors.editors[i].Edit.Editor.SetText(word)
+	}
+	pg.seedEditors.focusIndex = -1
+}
+
+// pasteSeedWords distributes words pasted into the seed editor at start over
+// the following editors. A complete seed is always entered from the first
+// editor.
+func (pg *SeedRestore) pasteSeedWords(start int, words []string) {
+	if len(words) >= len(pg.seedEditors.editors) {
+		start = 0
+	}
+
+	last := start
+	for j, word := range words {
+		if start+j >= len(pg.seedEditors.editors) {
+			break
+		}
+		last = start + j
+		pg.seedEditors.editors[last].Edit.Editor.SetText(word)
+	}
+	pg.seedEditors.editors[last].Edit.Editor.MoveCaret(len(words[last-start]), 0)
+	pg.seedEditors.editors[last].Edit.Editor.Focus()
+}
+
+// highlightInvalidSeedWords marks the editors of words that are not in the
+// PGP word list or that belong to the other half of the list than their
+// position.
+func (pg *SeedRestore) highlightInvalidSeedWords() {
+	for i := range pg.seedEditors.editors {
+		editor := &pg.seedEditors.editors[i]
+		editor.LineColor = pg.Theme.Color.Gray2
+		if editor.Edit.Editor.Focused() {
+			continue
+		}
+
+		switch wallet.SeedWordAt(editor.Edit.Editor.Text(), i) {
+		case wallet.SeedWordUnknown, wallet.SeedWordWrongPosition:
+			editor.LineColor = pg.Theme.Color.Danger
+		}
+	}
+}
+
+// diagnoseSeed is called when words fail the seed checksum. It offers
+// corrections that produce a valid checksum or reports the invalid words.
+func (pg *SeedRestore) diagnoseSeed(words []string) {
+	if corrections := wallet.SeedCorrections(words, 5); len(corrections) > 0 {
+		pg.showSeedCorrections(corrections)
+		return
+	}
+
+	var invalid []string
+	for i, word := range words {
+		if wallet.SeedWordAt(word, i) != wallet.SeedWordValid {
+			invalid = append(invalid, fmt.Sprintf("%d", i+1))
+		}
+	}
+	if len(invalid) > 0 {
+		pg.Toast.NotifyError(values.StringF(values.StrInvalidSeedWords, strings.Join(invalid, ", ")))
+		return
+	}
+	pg.Toast.NotifyError(values.String(values.StrInvalidSeedPhrase))
+}
+
+func (pg *SeedRestore) showSeedCorrections(corrections []wallet.SeedCorrection) {
+	pg.seedCorrections = corrections
+	pg.seedCorrectionButtons = make([]decredmaterial.Button, len(corrections))
+	for i, correction := range corrections {
+		var text string
+		if len(correction.Positions) == 2 {
+			text = values.StringF(values.StrSwapSeedWords, correction.Positions[0]+1, correction.Positions[1]+1)
+		} else {
+			position := correction.Positions[0]
+			text = values.StringF(values.StrReplaceSeedWord, position+1,
+				pg.seedEditors.editors[position].Edit.Editor.Text(), correction.Words[position])
+		}
+		pg.seedCorrectionButtons[i] = pg.Theme.OutlineButton(text)
+	}
+
+	pg.seedCorrectionsModal = modal.NewInfoModal(pg.Load).
+		Title(values.String(values.StrSeedCorrections)).
+		Body(values.String(values.StrSeedCorrectionsInfo)).
+		SetCancelable(true).
+		UseCustomWidget(func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, pg.seedCorrectionsLayout()...)
+		}).
+		NegativeButton(values.String(values.StrCancel), func() {})
+	pg.ParentWindow().ShowModal(pg.seedCorrectionsModal)
+}
+
+func (pg *SeedRestore) seedCorrectionsLayout() []layout.FlexChild {
+	children := make([]layout.FlexChild, len(pg.seedCorrectionButtons))
+	for i := range pg.seedCorrectionButtons {
+		i := i
+		children[i] = layout.Rigid(func(gtx C) D {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, pg.seedCorrectionButtons[i].Layout)
+		})
+	}
+	return children
+}
+
+func (pg *SeedRestore) handleSeedCorrections() {
+	for i := range pg.seedCorrectionButtons {
+		for pg.seedCorrectionButtons[i].Clicked() {
+			pg.setSeedWords(strings.Join(pg.seedCorrections[i].Words, " "))
+			pg.seedCorrectionsModal.Dismiss()
+			pg.seedCorrections, pg.seedCorrectionButtons = nil, nil
+			return
+		}
+	}
+}
+
 func (pg *SeedRestore) resetSeeds() {
 	for i := 0; i < len(pg.seedEditors.editors); i++ {
-		pg.seedEditors.editors[i].Edit.Editor.SetText("")
+		decredmaterial.WipeEditor(pg.seedEditors.editors[i].Edit.Editor)
 	}
 }
 
@@ -543,7 +679,7 @@ func switchSeedEditors(editors []decredmaterial.RestoreEditor) {
 func (pg *SeedRestore) HandleUserInteractions() {
 	focus := pg.seedEditors.focusIndex
 	if focus != -1 {
-		pg.suggestions = pg.suggestionSeeds(pg.seedEditors.editors[focus].Edit.Editor.Text())
+		pg.suggestions = pg.suggestionSeeds(pg.seedEditors.editors[focus].Edit.Editor.Text(), focus)
 		pg.seedMenu = pg.seedMenu[:len(pg.suggestions)]
 		if !pg.seedEditorChanged() {
 			for k, s := range pg.suggestions {
@@ -563,9 +699,9 @@ func (pg *SeedRestore) HandleUserInteractions() {
 			EnableName(true).
 			ShowWalletInfoTip(true).
 			SetParent(pg).
-			PasswordCreated(func(walletName, password string, m *modal.CreatePasswordModal) bool {
+			PasswordCreated(func(walletName string, password []byte, m *modal.CreatePasswordModal) bool {
 				go func() {
-					_, err := pg.WL.MultiWallet.RestoreWallet(walletName, pg.seedPhrase, password, dcrlibwallet.PassphraseTypePass)
+					_, err := pg.WL.MultiWallet.RestoreWallet(walletName, pg.seedPhrase, string(password), dcrlibwallet.PassphraseTypePass)
 					if err != nil {
 						m.SetError(components.TranslateErr(err))
 						m.SetLoading(false)
@@ -595,6 +731,8 @@ func (pg *SeedRestore) HandleUserInteractions() {
 	pg.editorSeedsEventsHandler()
 	pg.onSuggestionSeedsClicked()
 	pg.suggestionSeedEffect()
+	pg.handleSeedCorrections()
+	pg.highlightInvalidSeedWords()
 
 	if pg.seedEditorChanged() {
 		pg.suggestions = nil
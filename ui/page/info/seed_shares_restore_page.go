@@ -0,0 +1,201 @@
+package info
+
+import (
+	"fmt"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const SeedSharesRestorePageID = "seed_shares_restore"
+
+// SeedSharesRestorePage combines seed shares created by the seed backup flow
+// back into the wallet seed.
+type SeedSharesRestorePage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the ParentNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	seedRecovered func(seed string)
+
+	shareEditors []decredmaterial.Editor
+	sharesList   *widget.List
+
+	backButton    decredmaterial.IconButton
+	addShare      decredmaterial.Button
+	combineShares decredmaterial.Button
+	statusLabel   decredmaterial.Label
+}
+
+func NewSeedSharesRestorePage(l *load.Load, seedRecovered func(seed string)) *SeedSharesRestorePage {
+	pg := &SeedSharesRestorePage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(SeedSharesRestorePageID),
+		seedRecovered:    seedRecovered,
+		sharesList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		addShare:      l.Theme.OutlineButton(values.String(values.StrAddSeedShare)),
+		combineShares: l.Theme.Button(values.String(values.StrCombineSeedShares)),
+		statusLabel:   l.Theme.Body2(""),
+	}
+
+	pg.combineShares.Font.Weight = text.Medium
+	pg.addShare.Font.Weight = text.Medium
+
+	pg.backButton, _ = components.SubpageHeaderButtons(l)
+	pg.backButton.Icon = l.Theme.Icons.ContentClear
+
+	pg.addShareEditor()
+	pg.addShareEditor()
+
+	return pg
+}
+
+func (pg *SeedSharesRestorePage) addShareEditor() {
+	hint := values.StringF(values.StrSeedShareNumber, len(pg.shareEditors)+1)
+	editor := pg.Theme.Editor(new(widget.Editor), hint)
+	editor.Editor.SingleLine, editor.Editor.Submit = false, false
+	pg.shareEditors = append(pg.shareEditors, editor)
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *SeedSharesRestorePage) OnNavigatedTo() {}
+
+// validateShares decodes every entered share and reports how many more shares
+// are needed to recover the seed.
+func (pg *SeedSharesRestorePage) validateShares() []string {
+	var shares []string
+	threshold := 0
+	for i := range pg.shareEditors {
+		editor := &pg.shareEditors[i]
+		words := strings.TrimSpace(editor.Editor.Text())
+		if words == "" {
+			editor.SetError("")
+			continue
+		}
+
+		share, err := wallet.DecodeSeedShare(words)
+		if err != nil {
+			editor.SetError(values.String(values.StrInvalidSeedShare))
+			continue
+		}
+		editor.SetError("")
+		threshold = share.Threshold
+		shares = append(shares, words)
+	}
+
+	switch {
+	case threshold == 0:
+		pg.statusLabel.Text = ""
+	case len(shares) < threshold:
+		pg.statusLabel.Text = values.StringF(values.StrSeedSharesNeeded, threshold-len(shares))
+	default:
+		pg.statusLabel.Text = ""
+	}
+	pg.combineShares.SetEnabled(threshold > 0 && len(shares) >= threshold)
+
+	return shares
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *SeedSharesRestorePage) HandleUserInteractions() {
+	shares := pg.validateShares()
+
+	for pg.addShare.Clicked() {
+		if len(pg.shareEditors) < wallet.MaxSeedShares {
+			pg.addShareEditor()
+		}
+	}
+
+	for pg.combineShares.Clicked() {
+		seed, err := wallet.CombineSeedShares(shares)
+		if err != nil {
+			pg.Toast.NotifyError(fmt.Sprintf("%s: %v", values.String(values.StrInvalidSeedShare), err))
+			continue
+		}
+
+		for i := range pg.shareEditors {
+			decredmaterial.WipeEditor(pg.shareEditors[i].Editor)
+		}
+		pg.ParentNavigator().CloseCurrentPage()
+		pg.seedRecovered(seed)
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *SeedSharesRestorePage) OnNavigatedFrom() {}
+
+// Layout draws the page UI components into the provided layout context
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *SeedSharesRestorePage) Layout(gtx C) D {
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrRestoreFromSeedShares),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						label := pg.Theme.Body1(values.String(values.StrSeedSharesRestoreInfo))
+						label.Color = pg.Theme.Color.GrayText1
+						return label.Layout(gtx)
+					}),
+					layout.Flexed(1, func(gtx C) D {
+						return pg.Theme.List(pg.sharesList).Layout(gtx, len(pg.shareEditors), func(gtx C, i int) D {
+							return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.shareEditors[i].Layout)
+						})
+					}),
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, pg.statusLabel.Layout)
+					}),
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+							return layout.Flex{}.Layout(gtx,
+								layout.Rigid(pg.addShare.Layout),
+								layout.Flexed(1, func(gtx C) D {
+									return layout.E.Layout(gtx, pg.combineShares.Layout)
+								}),
+							)
+						})
+					}),
+				)
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView) {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return components.UniformPadding(gtx, body)
+}
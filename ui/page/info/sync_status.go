@@ -2,11 +2,14 @@ package info
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"gioui.org/layout"
+	"gioui.org/op"
 
 	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
 	"github.com/planetdecred/godcr/wallet"
@@ -15,12 +18,53 @@ import (
 func (pg *WalletInfo) initWalletStatusWidgets() {
 	pg.walletStatusIcon = decredmaterial.NewIcon(pg.Theme.Icons.ImageBrightness1)
 	pg.syncSwitch = pg.Theme.Switch()
+
+	pg.restartSync = pg.Theme.OutlineButton(values.String(values.StrRestartSync))
+	pg.restartSync.TextSize = values.TextSize14
+}
+
+// syncStalled returns true if the sync has not reported progress for longer
+// than the sync stall timeout.
+func (pg *WalletInfo) syncStalled(health wallet.SyncHealth) bool {
+	return pg.WL.MultiWallet.IsSyncing() && !health.LastProgress.IsZero() &&
+		time.Since(health.LastProgress) > pg.SyncStallTimeout()
+}
+
+// syncFailed returns true if the last sync ended with an error and the
+// wallets have not synced since.
+func (pg *WalletInfo) syncFailed(health wallet.SyncHealth) bool {
+	return health.LastError != "" && !pg.WL.MultiWallet.IsSyncing() && !pg.WL.MultiWallet.IsSynced()
+}
+
+// handleRestartSync restarts a stalled sync or retries a failed one without
+// waiting for the next automatic attempt.
+func (pg *WalletInfo) handleRestartSync() {
+	for pg.restartSync.Clicked() {
+		health := pg.WL.Wallet.SyncHealth()
+		go func() {
+			var err error
+			if pg.syncStalled(health) {
+				err = pg.WL.Wallet.RestartSync()
+			} else {
+				err = pg.WL.Wallet.SpvSync()
+			}
+			if err != nil {
+				pg.Toast.NotifyError(components.NetworkErrorText(err))
+			}
+		}()
+	}
 }
 
 // syncStatusSection lays out content for displaying sync status.
 func (pg *WalletInfo) syncStatusSection(gtx C) D {
 	syncing, rescanning := pg.WL.MultiWallet.IsSyncing(), pg.WL.MultiWallet.IsRescanning()
 	uniform := layout.Inset{Top: values.MarginPadding5, Bottom: values.MarginPadding5}
+
+	// Redraw every second so that a stalled sync and the time left until
+	// the next sync attempt are shown without waiting for a sync event.
+	if syncing || !pg.WL.Wallet.SyncHealth().NextRetry.IsZero() {
+		op.InvalidateOp{At: time.Now().Add(time.Second)}.Add(gtx.Ops)
+	}
 	return pg.Theme.Card().Layout(gtx, func(gtx C) D {
 		return components.Container{Padding: layout.Inset{
 			Top:    values.MarginPadding15,
@@ -46,6 +90,9 @@ func (pg *WalletInfo) syncStatusSection(gtx C) D {
 						return pg.syncDormantContent(gtx, uniform)
 					}
 				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.syncPolicyRow(gtx, uniform)
+				}),
 			)
 		})
 	})
@@ -82,6 +129,9 @@ func (pg *WalletInfo) syncBoxTitleRow(gtx C) D {
 				)
 			}
 
+			if pg.WL.Wallet.OfflineMode() {
+				return pg.Theme.Label(values.TextSize14, values.String(values.StrOfflineMode)).Layout(gtx)
+			}
 			return pg.Theme.Label(values.TextSize14, values.String(values.StrNoConnectedPeer)).Layout(gtx)
 		}),
 		layout.Flexed(1, func(gtx C) D {
@@ -92,7 +142,10 @@ func (pg *WalletInfo) syncBoxTitleRow(gtx C) D {
 
 func (pg *WalletInfo) syncStatusIcon(gtx C) D {
 	icon := pg.Theme.Icons.SyncingIcon
-	if pg.WL.MultiWallet.IsSynced() {
+	health := pg.WL.Wallet.SyncHealth()
+	if pg.syncStalled(health) || pg.syncFailed(health) {
+		icon = pg.Theme.Icons.FailedIcon
+	} else if pg.WL.MultiWallet.IsSynced() {
 		icon = pg.Theme.Icons.SuccessIcon
 	} else if pg.WL.MultiWallet.IsSyncing() {
 		icon = pg.Theme.Icons.SyncingIcon
@@ -111,10 +164,61 @@ func (pg *WalletInfo) syncDormantContent(gtx C, uniform layout.Inset) D {
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{Bottom: values.MarginPadding12}.Layout(gtx, pg.blockInfoRow)
 			}),
+			layout.Rigid(func(gtx C) D {
+				return pg.syncFailureDetails(gtx, uniform)
+			}),
 		)
 	})
 }
 
+// syncFailureDetails lays out the reason the last sync failed and when the
+// sync is attempted again.
+func (pg *WalletInfo) syncFailureDetails(gtx C, inset layout.Inset) D {
+	health := pg.WL.Wallet.SyncHealth()
+	if !pg.syncFailed(health) {
+		return D{}
+	}
+
+	col := pg.Theme.Color.GrayText2
+	row := func(title string, value decredmaterial.Label) layout.FlexChild {
+		return layout.Rigid(func(gtx C) D {
+			titleLabel := pg.Theme.Body2(title)
+			titleLabel.Color = col
+			return inset.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, titleLabel.Layout, value.Layout)
+			})
+		})
+	}
+
+	reason := pg.Theme.Body2(health.LastError)
+	reason.Color = pg.Theme.Color.Danger
+	children := []layout.FlexChild{
+		row(values.String(values.StrSyncErrorReason), reason),
+		row(values.String(values.StrFailedSyncAttempts), pg.Theme.Body2(fmt.Sprint(health.Failures))),
+	}
+	if !health.NextRetry.IsZero() {
+		timeLeft := components.TimeFormat(int(time.Until(health.NextRetry).Seconds()), true)
+		children = append(children, row(values.String(values.StrNextSyncRetry), pg.Theme.Body2(values.StringF(values.StrRetryingIn, timeLeft))))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// syncPolicyRow lays out the sync policy if one other than continuous sync
+// is set.
+func (pg *WalletInfo) syncPolicyRow(gtx C, inset layout.Inset) D {
+	policy := pg.WL.Wallet.SyncPolicy()
+	if policy == (wallet.SyncPolicy{}) {
+		return D{}
+	}
+
+	value := pg.Theme.Body2(modal.SyncPolicyName(policy))
+	titleLabel := pg.Theme.Body2(values.String(values.StrSyncPolicy))
+	titleLabel.Color = pg.Theme.Color.GrayText2
+	return inset.Layout(gtx, func(gtx C) D {
+		return components.EndToEndRow(gtx, titleLabel.Layout, value.Layout)
+	})
+}
+
 func (pg *WalletInfo) blockInfoRow(gtx C) D {
 	bestBlock := pg.WL.MultiWallet.GetBestBlock()
 	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
@@ -163,13 +267,24 @@ func (pg *WalletInfo) syncDetail(status, headersFetched, progress string) wallet
 func (pg *WalletInfo) syncStatusTextRow(gtx C) D {
 	syncing, rescanning := pg.WL.MultiWallet.IsSyncing(), pg.WL.MultiWallet.IsRescanning()
 
+	health := pg.WL.Wallet.SyncHealth()
+	stalled, failed := pg.syncStalled(health), pg.syncFailed(health)
+
 	syncStatusLabel := pg.Theme.Label(values.TextSize14, values.String(values.StrWalletNotSynced))
-	if pg.WL.MultiWallet.IsSyncing() {
+	if stalled {
+		syncStatusLabel.Text = values.String(values.StrSyncStalled)
+		syncStatusLabel.Color = pg.Theme.Color.Danger
+	} else if pg.WL.MultiWallet.IsSyncing() {
 		syncStatusLabel.Text = values.String(values.StrSyncingState)
 	} else if pg.WL.MultiWallet.IsRescanning() {
 		syncStatusLabel.Text = values.String(values.StrRescanningBlocks)
 	} else if pg.WL.MultiWallet.IsSynced() {
 		syncStatusLabel.Text = values.String(values.StrSynced)
+	} else if failed {
+		syncStatusLabel.Text = values.String(values.StrSyncFailed)
+		syncStatusLabel.Color = pg.Theme.Color.Danger
+	} else if health.Paused {
+		syncStatusLabel.Text = values.String(values.StrSyncPaused)
 	}
 
 	var children []layout.FlexChild
@@ -179,6 +294,16 @@ func (pg *WalletInfo) syncStatusTextRow(gtx C) D {
 		children = append(children, layout.Rigid(pg.progressBarRow))
 	}
 
+	if stalled || failed {
+		pg.restartSync.Text = values.String(values.StrRestartSync)
+		if failed {
+			pg.restartSync.Text = values.String(values.StrRetrySyncNow)
+		}
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Left: values.MarginPadding16}.Layout(gtx, pg.restartSync.Layout)
+		}))
+	}
+
 	return layout.Flex{
 		Axis:      layout.Horizontal,
 		Alignment: layout.Middle,
@@ -229,6 +354,10 @@ func (pg *WalletInfo) walletSyncRow(gtx C, inset layout.Inset) D {
 	if pg.WL.SelectedWallet.Wallet.IsWaiting() {
 		status = values.String(values.StrWaitingState)
 	}
+	if health := pg.WL.Wallet.SyncHealth(); pg.syncStalled(health) {
+		sinceProgress := components.TimeFormat(int(time.Since(health.LastProgress).Seconds()), true)
+		status = values.StringF(values.StrSyncStalledInfo, sinceProgress)
+	}
 
 	blockHeightProgress := values.StringF(values.StrBlockHeaderFetchedCount, w.GetBestBlock(), pg.headersToFetchOrScan)
 	daysBehind := components.TimeFormat(int(currentSeconds-w.GetBestBlockTimeStamp()), true)
@@ -323,6 +452,24 @@ func (pg *WalletInfo) rescanDetailsLayout(gtx C, inset layout.Inset) D {
 							return components.EndToEndRow(gtx, progressTitleLabel.Layout, blocksScannedLabel.Layout)
 						})
 					}),
+					layout.Rigid(func(gtx C) D {
+						queued := pg.WL.Wallet.QueuedRescans()
+						if len(queued) == 0 {
+							return D{}
+						}
+
+						names := make([]string, 0, len(queued))
+						for _, rescan := range queued {
+							if w := pg.WL.MultiWallet.WalletWithID(rescan.WalletID); w != nil {
+								names = append(names, w.Name)
+							}
+						}
+						queuedTitleLabel := pg.Theme.Body2(values.String(values.StrQueuedRescans))
+						queuedTitleLabel.Color = pg.Theme.Color.GrayText2
+						return inset.Layout(gtx, func(gtx C) D {
+							return components.EndToEndRow(gtx, queuedTitleLabel.Layout, pg.Theme.Body1(strings.Join(names, ", ")).Layout)
+						})
+					}),
 				)
 			})
 		})
@@ -0,0 +1,146 @@
+package page
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/planetdecred/godcr/ui/values"
+)
+
+// maxLogEntries is the number of log entries the log page keeps. The oldest
+// are dropped as new entries are logged.
+const maxLogEntries = 5000
+
+// logTimeFormat is the layout of the timestamp slog starts each line with.
+const logTimeFormat = "2006-01-02 15:04:05.000"
+
+// logLevel is a log level by its name in the debuglevel option and the tag
+// slog writes for it.
+type logLevel struct {
+	name string
+	tag  string
+	text string
+}
+
+// logLevels are the log levels, the most verbose first.
+var logLevels = []logLevel{
+	{"trace", "TRC", values.StrLevelTrace},
+	{"debug", "DBG", values.StrLevelDebug},
+	{"info", "INF", values.StrLevelInfo},
+	{"warn", "WRN", values.StrLevelWarn},
+	{"error", "ERR", values.StrLevelError},
+	{"critical", "CRT", values.StrLevelCritical},
+	{"off", "OFF", values.StrLevelOff},
+}
+
+// logLevelRank returns the index in logLevels of the level with tag, or -1
+// if tag is not a level.
+func logLevelRank(tag string) int {
+	for i, level := range logLevels {
+		if level.tag == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+// logEntry is a parsed log line, such as
+// "2022-08-02 15:04:05.000 [INF] WALL: Wallet loaded". The lines that follow
+// it and are not slog lines, such as the lines of a stack trace, are added
+// to the message.
+type logEntry struct {
+	time      string
+	level     string
+	rank      int
+	subsystem string
+	message   string
+}
+
+// parseLogLine returns the entry of line, or false if line is not a slog
+// line.
+func parseLogLine(line string) (logEntry, bool) {
+	// The timestamp is followed by " [LVL] SUBSYS: ".
+	n := len(logTimeFormat)
+	if len(line) < n+8 || line[n:n+2] != " [" || line[n+5:n+7] != "] " {
+		return logEntry{}, false
+	}
+	entry := logEntry{
+		time:  line[:n],
+		level: line[n+2 : n+5],
+	}
+	entry.rank = logLevelRank(entry.level)
+	if entry.rank < 0 {
+		return logEntry{}, false
+	}
+
+	rest := line[n+7:]
+	i := strings.Index(rest, ": ")
+	if i < 0 {
+		return logEntry{}, false
+	}
+	entry.subsystem, entry.message = rest[:i], rest[i+2:]
+	return entry, true
+}
+
+// text returns the entry as it was logged.
+func (entry logEntry) text() string {
+	if entry.level == "" {
+		return entry.message
+	}
+	return entry.time + " [" + entry.level + "] " + entry.subsystem + ": " + entry.message
+}
+
+// logBuffer keeps the last maxLogEntries log entries. The entries are added
+// by the goroutine that tails the log file and read by the page.
+type logBuffer struct {
+	mu      sync.Mutex
+	entries []logEntry
+	start   int
+
+	// version changes whenever an entry is added or changed, so the page
+	// only filters the entries again when they changed.
+	version uint64
+}
+
+// add parses line and adds its entry, or adds line to the message of the
+// last entry if it is not a slog line.
+func (b *logBuffer) add(line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.version++
+
+	entry, ok := parseLogLine(line)
+	if !ok && len(b.entries) > 0 {
+		last := &b.entries[(b.start+len(b.entries)-1)%len(b.entries)]
+		last.message += "\n" + line
+		return
+	}
+	if !ok {
+		entry = logEntry{rank: -1, message: line}
+	}
+
+	if len(b.entries) < maxLogEntries {
+		b.entries = append(b.entries, entry)
+		return
+	}
+	b.entries[b.start] = entry
+	b.start = (b.start + 1) % maxLogEntries
+}
+
+// snapshot returns the entries, the oldest first, and the version of the
+// buffer.
+func (b *logBuffer) snapshot() ([]logEntry, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entries := make([]logEntry, 0, len(b.entries))
+	entries = append(entries, b.entries[b.start:]...)
+	entries = append(entries, b.entries[:b.start]...)
+	return entries, b.version
+}
+
+// changed returns true if the buffer changed since version.
+func (b *logBuffer) changed(version uint64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.version != version
+}
@@ -2,11 +2,20 @@ package page
 
 import (
 	"fmt"
+	"image"
 	"os"
 	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"gioui.org/io/clipboard"
 	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
 	"gioui.org/widget"
 
 	"github.com/nxadm/tail"
@@ -19,9 +28,19 @@ import (
 
 const (
 	LogPageID = "Log"
-	LogOffset = 24000
+	LogOffset = 512 * 1024
+
+	// logReloadInterval is the shortest time between the redraws of the
+	// page for new log lines.
+	logReloadInterval = 250 * time.Millisecond
+
+	// logFilterLevels is the number of levels, from trace, the entries can
+	// be filtered by.
+	logFilterLevels = 5
 )
 
+// LogPage shows the entries of the app log, filtered by level, subsystem and
+// text, and changes the log levels.
 type LogPage struct {
 	*load.Load
 	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
@@ -30,14 +49,37 @@ type LogPage struct {
 	// and the root WindowNavigator.
 	*app.GenericPageModal
 
-	tail *tail.Tail
+	tail   *tail.Tail
+	buffer *logBuffer
+
+	// reloadPending is 1 while a redraw for new log lines is scheduled.
+	reloadPending int32
 
-	copyLog    *decredmaterial.Clickable
-	copyIcon   *decredmaterial.Image
-	backButton decredmaterial.IconButton
+	copyLog     *decredmaterial.Clickable
+	copyIcon    *decredmaterial.Image
+	copyPending bool
+	backButton  decredmaterial.IconButton
 
 	logList *widget.List
-	fullLog string
+
+	// entries are the entries of the buffer at version and view are those
+	// that pass the filters. entries are not updated while paused.
+	entries []logEntry
+	version uint64
+	view    []logEntry
+
+	levelFilter      *decredmaterial.SwitchButtonText
+	subsystems       []string
+	subsystemFilters map[string]*widget.Bool
+	searchEditor     decredmaterial.Editor
+	search           string
+
+	following    bool
+	followButton decredmaterial.Button
+
+	showLevels   bool
+	levelsButton decredmaterial.Button
+	levelSwitch  map[string]*decredmaterial.SwitchButtonText
 }
 
 func NewLogPage(l *load.Load) *LogPage {
@@ -50,13 +92,42 @@ func NewLogPage(l *load.Load) *LogPage {
 				ScrollToEnd: true,
 			},
 		},
-		copyLog: l.Theme.NewClickable(true),
+		copyLog:          l.Theme.NewClickable(true),
+		subsystemFilters: make(map[string]*widget.Bool),
+		levelSwitch:      make(map[string]*decredmaterial.SwitchButtonText),
+		following:        true,
+		followButton:     l.Theme.OutlineButton(values.String(values.StrPause)),
+		levelsButton:     l.Theme.OutlineButton(values.String(values.StrLogLevels)),
 	}
-
 	pg.copyIcon = pg.Theme.Icons.CopyIcon
+	pg.followButton.TextSize = values.TextSize14
+	pg.levelsButton.TextSize = values.TextSize14
+
+	pg.searchEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrSearchLog))
+	pg.searchEditor.Editor.SingleLine = true
+
+	filterLevels := make([]decredmaterial.SwitchItem, logFilterLevels)
+	for i := range filterLevels {
+		filterLevels[i].Text = values.String(logLevels[i].text)
+	}
+	pg.levelFilter = l.Theme.SwitchButtonText(filterLevels)
+
+	if l.LogLevels != nil {
+		for subsystem := range l.LogLevels() {
+			pg.subsystems = append(pg.subsystems, subsystem)
+		}
+		sort.Strings(pg.subsystems)
+	}
+	allLevels := make([]decredmaterial.SwitchItem, len(logLevels))
+	for i := range allLevels {
+		allLevels[i].Text = values.String(logLevels[i].text)
+	}
+	for _, subsystem := range pg.subsystems {
+		pg.subsystemFilters[subsystem] = &widget.Bool{Value: true}
+		pg.levelSwitch[subsystem] = l.Theme.SwitchButtonText(allLevels)
+	}
 
 	pg.backButton, _ = components.SubpageHeaderButtons(l)
-	pg.watchLogs()
 	return pg
 }
 
@@ -65,62 +136,183 @@ func NewLogPage(l *load.Load) *LogPage {
 // the page is displayed.
 // Part of the load.Page interface.
 func (pg *LogPage) OnNavigatedTo() {
+	pg.buffer = new(logBuffer)
+	pg.entries, pg.version, pg.view = nil, 0, nil
+	pg.showCurrentLevels()
 	pg.watchLogs()
 }
 
-func (pg *LogPage) copyLogEntries(gtx C) {
-	go func() {
-		clipboard.WriteOp{Text: pg.fullLog}.Add(gtx.Ops)
-	}()
-}
-
+// watchLogs starts tailing the log file. The tail is made before the lines
+// are read in a goroutine, so OnNavigatedFrom always sees it.
 func (pg *LogPage) watchLogs() {
-	go func() {
-		logPath := pg.Load.WL.Wallet.LogFile()
+	buffer := pg.buffer
+	logPath := pg.Load.WL.Wallet.LogFile()
 
-		fi, err := os.Stat(logPath)
-		if err != nil {
-			pg.fullLog = fmt.Sprintf("unable to open log file: %v", err)
-			return
-		}
+	fi, err := os.Stat(logPath)
+	if err != nil {
+		buffer.add(fmt.Sprintf("unable to open log file: %v", err))
+		return
+	}
 
-		size := fi.Size()
+	size := fi.Size()
 
-		var offset int64
-		if size > LogOffset*2 {
-			offset = size - LogOffset
-		}
+	var offset int64
+	if size > LogOffset*2 {
+		offset = size - LogOffset
+	}
 
-		pollLogs := runtime.GOOS == "windows"
-		t, err := tail.TailFile(logPath, tail.Config{Follow: true, Poll: pollLogs, Location: &tail.SeekInfo{Offset: offset}})
-		if err != nil {
-			pg.fullLog = fmt.Sprintf("unable to tail log file: %v", err)
-			return
-		}
-		pg.tail = t
+	pollLogs := runtime.GOOS == "windows"
+	t, err := tail.TailFile(logPath, tail.Config{Follow: true, Poll: pollLogs, Location: &tail.SeekInfo{Offset: offset}})
+	if err != nil {
+		buffer.add(fmt.Sprintf("unable to tail log file: %v", err))
+		return
+	}
+	pg.tail = t
+
+	go func() {
+		defer pg.RecoverPanic()
 
 		if offset > 0 {
 			// skip the first line because it might be truncated.
 			<-t.Lines
 		}
 		for line := range t.Lines {
-			pg.fullLog += line.Text + "\n"
-			pg.ParentWindow().Reload()
+			buffer.add(line.Text)
+
+			// The lines of a burst are drawn together.
+			if atomic.CompareAndSwapInt32(&pg.reloadPending, 0, 1) {
+				time.AfterFunc(logReloadInterval, func() {
+					atomic.StoreInt32(&pg.reloadPending, 0)
+					pg.ParentWindow().Reload()
+				})
+			}
 		}
 	}()
 }
 
+// showCurrentLevels selects the current log level of each subsystem.
+func (pg *LogPage) showCurrentLevels() {
+	if pg.LogLevels == nil {
+		return
+	}
+	for subsystem, name := range pg.LogLevels() {
+		levelSwitch, ok := pg.levelSwitch[subsystem]
+		if !ok {
+			continue
+		}
+		for i, level := range logLevels {
+			if level.name == name {
+				levelSwitch.SetSelectedIndex(i + 1)
+			}
+		}
+		levelSwitch.Changed()
+	}
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *LogPage) HandleUserInteractions() {
+	filterChanged := pg.levelFilter.Changed()
+	for _, filter := range pg.subsystemFilters {
+		if filter.Changed() {
+			filterChanged = true
+		}
+	}
+	if search := strings.ToLower(strings.TrimSpace(pg.searchEditor.Editor.Text())); search != pg.search {
+		pg.search = search
+		filterChanged = true
+	}
+
+	for pg.followButton.Clicked() {
+		pg.following = !pg.following
+		pg.logList.ScrollToEnd = pg.following
+		pg.followButton.Text = values.String(values.StrPause)
+		if !pg.following {
+			pg.followButton.Text = values.String(values.StrFollow)
+		}
+	}
+
+	if pg.following && pg.buffer != nil && pg.buffer.changed(pg.version) {
+		pg.entries, pg.version = pg.buffer.snapshot()
+		filterChanged = true
+	}
+	if filterChanged {
+		pg.filter()
+	}
+
+	for pg.levelsButton.Clicked() {
+		pg.showLevels = !pg.showLevels
+	}
+
+	for subsystem, levelSwitch := range pg.levelSwitch {
+		if !levelSwitch.Changed() {
+			continue
+		}
+		level := logLevels[levelSwitch.SelectedIndex()-1]
+		if err := pg.SetLogLevels(subsystem + "=" + level.name); err != nil {
+			pg.Toast.NotifyError(err.Error())
+			pg.showCurrentLevels()
+			continue
+		}
+		log.Infof("%s log level set to %s", subsystem, level.name)
+	}
+
+	if pg.copyLog.Clicked() {
+		pg.copyPending = true
+	}
+}
+
+// filter sets the view to the entries that pass the level, subsystem and
+// search filters. The lines that are not slog lines always pass the level
+// and subsystem filters.
+func (pg *LogPage) filter() {
+	minRank := pg.levelFilter.SelectedIndex() - 1
+	pg.view = pg.view[:0]
+	for _, entry := range pg.entries {
+		if entry.rank >= 0 && entry.rank < minRank {
+			continue
+		}
+		if filter, ok := pg.subsystemFilters[entry.subsystem]; ok && !filter.Value {
+			continue
+		}
+		if pg.search != "" && !strings.Contains(strings.ToLower(entry.text()), pg.search) {
+			continue
+		}
+		pg.view = append(pg.view, entry)
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *LogPage) OnNavigatedFrom() {
+	if pg.tail != nil {
+		pg.tail.Stop()
+		pg.tail = nil
+	}
+}
+
 // Layout draws the page UI components into the provided layout context
 // to be eventually drawn on screen.
 // Part of the load.Page interface.
 func (pg *LogPage) Layout(gtx C) D {
-	if pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView) {
-		return pg.layoutMobile(gtx)
+	if pg.copyPending {
+		lines := make([]string, len(pg.view))
+		for i, entry := range pg.view {
+			lines[i] = entry.text()
+		}
+		clipboard.WriteOp{Text: strings.Join(lines, "\n")}.Add(gtx.Ops)
+		pg.Toast.Notify(values.String(values.StrCopied))
 	}
-	return pg.layoutDesktop(gtx)
-}
+	pg.copyPending = false
 
-func (pg *LogPage) layoutDesktop(gtx layout.Context) layout.Dimensions {
 	container := func(gtx C) D {
 		sp := components.SubPage{
 			Load:       pg.Load,
@@ -135,89 +327,229 @@ func (pg *LogPage) layoutDesktop(gtx layout.Context) layout.Dimensions {
 					return pg.copyLog.Layout(gtx, func(gtx C) D {
 						return pg.copyIcon.Layout24dp(gtx)
 					})
-
 				})
 			},
-			HandleExtra: func() {
-				pg.copyLogEntries(gtx)
-				pg.Toast.Notify(values.String(values.StrCopied))
-			},
 			Body: func(gtx C) D {
 				gtx.Constraints.Min.X = gtx.Constraints.Max.X
-				gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
-				return pg.Theme.List(pg.logList).Layout(gtx, 1, func(gtx C, index int) D {
-					return layout.Inset{Right: values.MarginPadding2}.Layout(gtx, func(gtx C) D {
-						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
-							return layout.UniformInset(values.MarginPadding15).Layout(gtx, func(gtx C) D {
-								return pg.Theme.Body1(pg.fullLog).Layout(gtx)
-							})
-						})
-					})
-				})
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(pg.layoutFilters),
+					layout.Rigid(func(gtx C) D {
+						if !pg.showLevels || len(pg.subsystems) == 0 {
+							return D{}
+						}
+						return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, pg.layoutLevels)
+					}),
+					layout.Flexed(1, func(gtx C) D {
+						return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, pg.layoutEntries)
+					}),
+				)
 			},
 		}
 		return sp.Layout(pg.ParentWindow(), gtx)
 	}
+
+	if pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView) {
+		return components.UniformMobile(gtx, false, false, container)
+	}
 	return components.UniformPadding(gtx, container)
 }
 
-func (pg *LogPage) layoutMobile(gtx layout.Context) layout.Dimensions {
-	container := func(gtx C) D {
-		sp := components.SubPage{
-			Load:       pg.Load,
-			Title:      values.String(values.StrWalletLog),
-			BackButton: pg.backButton,
-			Back: func() {
-				pg.ParentNavigator().CloseCurrentPage()
-			},
-			ExtraItem: pg.copyLog,
-			Extra: func(gtx C) D {
-				return layout.Center.Layout(gtx, func(gtx C) D {
-					return pg.copyLog.Layout(gtx, func(gtx C) D {
-						return pg.copyIcon.Layout24dp(gtx)
+func (pg *LogPage) layoutFilters(gtx C) D {
+	return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		return layout.UniformInset(values.MarginPadding12).Layout(gtx, func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+						layout.Flexed(1, pg.searchEditor.Layout),
+						layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+						layout.Rigid(pg.followButton.Layout),
+						layout.Rigid(func(gtx C) D {
+							if len(pg.subsystems) == 0 {
+								return D{}
+							}
+							return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, pg.levelsButton.Layout)
+						}),
+					)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+						children := []layout.FlexChild{
+							layout.Rigid(pg.levelFilter.Layout),
+							layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+						}
+						for _, subsystem := range pg.subsystems {
+							checkBox := pg.Theme.CheckBox(pg.subsystemFilters[subsystem], subsystem)
+							checkBox.TextSize = values.TextSize14
+							children = append(children, layout.Rigid(checkBox.Layout))
+						}
+						children = append(children, layout.Flexed(1, func(gtx C) D {
+							lbl := pg.Theme.Caption(values.StringF(values.StrLogEntriesShown, len(pg.view), len(pg.entries)))
+							lbl.Color = pg.Theme.Color.GrayText2
+							return layout.E.Layout(gtx, lbl.Layout)
+						}))
+						return layout.Flex{Alignment: layout.Middle}.Layout(gtx, children...)
 					})
+				}),
+			)
+		})
+	})
+}
 
-				})
-			},
-			HandleExtra: func() {
-				pg.copyLogEntries(gtx)
-				pg.Toast.Notify(values.String(values.StrCopied))
-			},
-			Body: func(gtx C) D {
-				gtx.Constraints.Min.X = gtx.Constraints.Max.X
-				gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
-				return pg.Theme.List(pg.logList).Layout(gtx, 1, func(gtx C, index int) D {
-					return layout.Inset{Right: values.MarginPadding2}.Layout(gtx, func(gtx C) D {
-						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
-							return layout.UniformInset(values.MarginPadding15).Layout(gtx, func(gtx C) D {
-								return pg.Theme.Body1(pg.fullLog).Layout(gtx)
-							})
-						})
+func (pg *LogPage) layoutLevels(gtx C) D {
+	return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		return layout.UniformInset(values.MarginPadding12).Layout(gtx, func(gtx C) D {
+			rows := []layout.FlexChild{
+				layout.Rigid(func(gtx C) D {
+					lbl := pg.Theme.Caption(values.String(values.StrLogLevelsInfo))
+					lbl.Color = pg.Theme.Color.GrayText2
+					return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+				}),
+			}
+			for _, subsystem := range pg.subsystems {
+				subsystem := subsystem
+				rows = append(rows, layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding4, Bottom: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+						return components.EndToEndRow(gtx, pg.Theme.Body2(subsystem).Layout, pg.levelSwitch[subsystem].Layout)
 					})
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+		})
+	})
+}
+
+func (pg *LogPage) layoutEntries(gtx C) D {
+	gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
+	return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		return layout.UniformInset(values.MarginPadding15).Layout(gtx, func(gtx C) D {
+			return pg.Theme.List(pg.logList).Layout(gtx, len(pg.view), func(gtx C, i int) D {
+				return layout.Inset{Bottom: values.MarginPadding4, Right: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+					return pg.layoutEntry(gtx, pg.view[i])
 				})
-			},
+			})
+		})
+	})
+}
+
+func (pg *LogPage) layoutEntry(gtx C, entry logEntry) D {
+	if entry.level == "" {
+		return pg.layoutMessage(gtx, entry.message)
+	}
+
+	level := pg.Theme.Caption(entry.level)
+	level.Font.Weight = text.SemiBold
+	switch {
+	case entry.rank >= logLevelRank("ERR"):
+		level.Color = pg.Theme.Color.Danger
+	case entry.rank == logLevelRank("WRN"):
+		level.Color = pg.Theme.Color.Orange
+	case entry.rank <= logLevelRank("DBG"):
+		level.Color = pg.Theme.Color.GrayText3
+	}
+	timestamp := pg.Theme.Caption(entry.time)
+	timestamp.Color = pg.Theme.Color.GrayText2
+
+	return layout.Flex{}.Layout(gtx,
+		layout.Rigid(timestamp.Layout),
+		layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+		layout.Rigid(level.Layout),
+		layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+		layout.Rigid(pg.Theme.Caption(entry.subsystem).Layout),
+		layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+		layout.Flexed(1, func(gtx C) D {
+			return pg.layoutMessage(gtx, entry.message)
+		}),
+	)
+}
+
+// logSpan is a part of a log message and whether it matches the search.
+type logSpan struct {
+	text  string
+	match bool
+}
+
+// layoutMessage draws message with the matches of the search highlighted.
+func (pg *LogPage) layoutMessage(gtx C, message string) D {
+	lower := strings.ToLower(message)
+	// Lowering some characters changes their length, the matches of such
+	// messages are not highlighted.
+	if pg.search == "" || len(lower) != len(message) || !strings.Contains(lower, pg.search) {
+		return pg.Theme.Caption(message).Layout(gtx)
+	}
+
+	var spans []logSpan
+	for {
+		i := strings.Index(lower, pg.search)
+		if i < 0 {
+			break
 		}
-		return sp.Layout(pg.ParentWindow(), gtx)
+		end := i + len(pg.search)
+		if i > 0 {
+			spans = append(spans, logSpan{text: message[:i]})
+		}
+		spans = append(spans, logSpan{text: message[i:end], match: true})
+		message, lower = message[end:], lower[end:]
+	}
+	if message != "" {
+		spans = append(spans, logSpan{text: message})
 	}
-	return components.UniformMobile(gtx, false, false, container)
+	return pg.layoutSpans(gtx, spans)
 }
 
-// HandleUserInteractions is called just before Layout() to determine
-// if any user interaction recently occurred on the page and may be
-// used to update the page's UI components shortly before they are
-// displayed.
-// Part of the load.Page interface.
-func (pg *LogPage) HandleUserInteractions() {}
+// layoutSpans draws spans a word at a time, wrapping at the width of gtx,
+// with the matching spans on a highlighted background.
+func (pg *LogPage) layoutSpans(gtx C, spans []logSpan) D {
+	maxWidth := gtx.Constraints.Max.X
+	var x, y, width, lineHeight, lastLineHeight int
+	newLine := func() {
+		if lineHeight == 0 {
+			lineHeight = lastLineHeight
+		}
+		y += lineHeight
+		x, lastLineHeight, lineHeight = 0, lineHeight, 0
+	}
 
-// OnNavigatedFrom is called when the page is about to be removed from
-// the displayed window. This method should ideally be used to disable
-// features that are irrelevant when the page is NOT displayed.
-// NOTE: The page may be re-displayed on the app's window, in which case
-// OnNavigatedTo() will be called again. This method should not destroy UI
-// components unless they'll be recreated in the OnNavigatedTo() method.
-// Part of the load.Page interface.
-func (pg *LogPage) OnNavigatedFrom() {
-	if pg.tail != nil {
-		pg.tail.Stop()
+	wordGtx := gtx
+	wordGtx.Constraints.Min = image.Point{}
+	for _, span := range spans {
+		for i, part := range strings.Split(span.text, "\n") {
+			if i > 0 {
+				newLine()
+			}
+			for _, word := range strings.SplitAfter(part, " ") {
+				if word == "" {
+					continue
+				}
+				lbl := pg.Theme.Caption(word)
+				if span.match {
+					lbl.Color = pg.Theme.Color.Black
+				}
+				macro := op.Record(gtx.Ops)
+				dims := lbl.Layout(wordGtx)
+				call := macro.Stop()
+
+				if x > 0 && x+dims.Size.X > maxWidth {
+					newLine()
+				}
+				offset := op.Offset(image.Pt(x, y)).Push(gtx.Ops)
+				if span.match {
+					paint.FillShape(gtx.Ops, pg.Theme.Color.Yellow, clip.Rect{Max: dims.Size}.Op())
+				}
+				call.Add(gtx.Ops)
+				offset.Pop()
+
+				x += dims.Size.X
+				if x > width {
+					width = x
+				}
+				if dims.Size.Y > lineHeight {
+					lineHeight = dims.Size.Y
+				}
+			}
+		}
 	}
+	return D{Size: image.Pt(width, y+lineHeight)}
 }
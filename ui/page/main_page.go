@@ -65,9 +65,6 @@ type MainPage struct {
 	*app.MasterPage
 
 	*load.Load
-	*listeners.SyncProgressListener
-	*listeners.TxAndBlockNotificationListener
-	*listeners.ProposalNotificationListener
 
 	ctx                  context.Context
 	ctxCancel            context.CancelFunc
@@ -76,6 +73,7 @@ type MainPage struct {
 	floatingActionButton components.BottomNavigationBar
 
 	hideBalanceItem HideBalanceItem
+	taskCentre      *taskCentre
 
 	sendPage    *send.Page   // reuse value to keep data persistent onresume.
 	receivePage *ReceivePage // pointer to receive page. to avoid duplication.
@@ -91,7 +89,6 @@ type MainPage struct {
 
 	usdExchangeSet         bool
 	isFetchingExchangeRate bool
-	isBalanceHidden        bool
 	isNavExpanded          bool
 
 	setNavExpanded  func()
@@ -110,6 +107,8 @@ func NewMainPage(l *load.Load) *MainPage {
 	mp.hideBalanceItem.hideBalanceButton.Inset = layout.UniformInset(values.MarginPadding4)
 	mp.hideBalanceItem.tooltip = mp.Theme.Tooltip()
 
+	mp.taskCentre = newTaskCentre(l)
+
 	mp.darkmode = mp.Theme.NewClickable(false)
 	mp.openWalletSelector = mp.Theme.NewClickable(false)
 	mp.openWalletSelector.Radius = decredmaterial.Radius(8)
@@ -285,6 +284,14 @@ func (mp *MainPage) OnNavigatedTo() {
 		mp.showBackupInfo()
 	}
 
+	// A crash also leaves the session unclean, so it is reported instead.
+	if report := mp.WL.Wallet.UnseenCrashReport(); report != nil {
+		mp.WL.Wallet.DismissUncleanShutdown()
+		mp.showCrashInfo(report)
+	} else if session := mp.WL.Wallet.UncleanShutdown(); session != nil {
+		mp.showUncleanShutdownInfo(session)
+	}
+
 	if mp.CurrentPage() == nil {
 		mp.Display(info.NewInfoPage(mp.Load)) // TODO: Should pagestack have a start page?
 	}
@@ -299,8 +306,8 @@ func (mp *MainPage) OnNavigatedTo() {
 
 	if mp.WL.MultiWallet.ReadBoolConfigValueForKey(load.AutoSyncConfigKey, false) {
 		mp.StartSyncing()
-		if mp.WL.MultiWallet.ReadBoolConfigValueForKey(load.FetchProposalConfigKey, false) {
-			go mp.WL.MultiWallet.Politeia.Sync()
+		if mp.WL.MultiWallet.ReadBoolConfigValueForKey(load.FetchProposalConfigKey, false) && mp.WL.Wallet.FeatureError(wallet.FeatureGovernance) == nil {
+			go mp.WL.Wallet.SyncPoliteia()
 		}
 	}
 
@@ -323,7 +330,7 @@ func (mp *MainPage) updateExchangeSetting() {
 		mp.WL.MultiWallet.SaveUserConfigValue(dcrlibwallet.CurrencyConversionConfigKey, values.DefaultExchangeValue)
 	}
 
-	usdExchangeSet := currencyExchangeValue == values.USDExchangeValue
+	usdExchangeSet := currencyExchangeValue == values.USDExchangeValue && mp.WL.Wallet.FeatureError(wallet.FeatureExchangeRate) == nil
 	if mp.usdExchangeSet == usdExchangeSet {
 		return // nothing has changed
 	}
@@ -342,7 +349,7 @@ func (mp *MainPage) fetchExchangeRate() {
 	mp.isFetchingExchangeRate = true
 	desc := "for getting dcrUsdtBittrex exchange rate value"
 	attempts, err := components.RetryFunc(maxAttempts, delayBtwAttempts, desc, func() error {
-		return load.GetUSDExchangeValue(&mp.dcrUsdtBittrex)
+		return mp.WL.Wallet.GetUSDExchangeValues(&mp.dcrUsdtBittrex)
 	})
 	if err != nil {
 		log.Errorf("error fetching usd exchange rate value after %d attempts: %v", attempts, err)
@@ -379,10 +386,10 @@ func (mp *MainPage) StartSyncing() {
 		}
 	}
 
-	err := mp.WL.MultiWallet.SpvSync()
+	err := mp.WL.Wallet.SpvSync()
 	if err != nil {
-		// show error dialog
 		log.Info("Error starting sync:", err)
+		mp.Toast.NotifyError(components.NetworkErrorText(err))
 	}
 }
 
@@ -391,18 +398,14 @@ func (mp *MainPage) UnlockWalletForSyncing(wal *dcrlibwallet.Wallet) {
 		Title(values.String(values.StrResumeAccountDiscoveryTitle)).
 		Hint(values.String(values.StrSpendingPassword)).
 		NegativeButton(values.String(values.StrCancel), func() {}).
-		PositiveButton(values.String(values.StrUnlock), func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton(values.String(values.StrUnlock), func(password []byte, pm *modal.PasswordModal) bool {
 			go func() {
-				err := mp.WL.MultiWallet.UnlockWallet(wal.ID, []byte(password))
+				err := mp.WL.MultiWallet.UnlockWallet(wal.ID, password)
 				if err != nil {
-					errText := err.Error()
-					if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
-						errText = values.String(values.StrInvalidPassphrase)
-					}
-					pm.SetError(errText)
-					pm.SetLoading(false)
+					pm.Failed(err)
 					return
 				}
+				pm.Accepted()
 				pm.Dismiss()
 				mp.StartSyncing()
 			}()
@@ -582,13 +585,32 @@ func (mp *MainPage) HandleUserInteractions() {
 		}
 	}
 
-	mp.isBalanceHidden = mp.WL.MultiWallet.ReadBoolConfigValueForKey(load.HideBalanceConfigKey, false)
 	for mp.hideBalanceItem.hideBalanceButton.Button.Clicked() {
-		mp.isBalanceHidden = !mp.isBalanceHidden
-		mp.WL.MultiWallet.SetBoolConfigValueForKey(load.HideBalanceConfigKey, mp.isBalanceHidden)
+		mp.SetPrivacyMode(!mp.IsPrivacyModeOn())
+	}
+
+	mp.taskCentre.handle()
+
+	// Payment URIs the app was opened with wait until the wallets are
+	// synced, as the send page needs.
+	if mp.WL.MultiWallet.IsSynced() {
+		if req := mp.WL.Wallet.TakePaymentRequest(); req != nil {
+			mp.openPaymentRequest(req)
+		}
 	}
 }
 
+// openPaymentRequest displays the send page filled in with req.
+func (mp *MainPage) openPaymentRequest(req *wallet.PaymentRequest) {
+	if mp.sendPage == nil {
+		mp.sendPage = send.NewSendPage(mp.Load)
+	}
+	if mp.CurrentPageID() != send.SendPageID {
+		mp.Display(mp.sendPage)
+	}
+	mp.sendPage.SetPaymentRequest(req)
+}
+
 // KeysToHandle returns an expression that describes a set of key combinations
 // that this page wishes to capture. The HandleKeyPress() method will only be
 // called when any of these key combinations is pressed.
@@ -649,7 +671,7 @@ func (mp *MainPage) Layout(gtx layout.Context) layout.Dimensions {
 }
 
 func (mp *MainPage) layoutDesktop(gtx layout.Context) layout.Dimensions {
-	return layout.Stack{}.Layout(gtx,
+	return layout.Stack{Alignment: layout.NE}.Layout(gtx,
 		layout.Expanded(func(gtx C) D {
 			return decredmaterial.LinearLayout{
 				Width:       decredmaterial.MatchParent,
@@ -674,27 +696,33 @@ func (mp *MainPage) layoutDesktop(gtx layout.Context) layout.Dimensions {
 				}),
 			)
 		}),
+		layout.Stacked(mp.taskCentre.layoutPanel),
 	)
 }
 
 func (mp *MainPage) layoutMobile(gtx layout.Context) layout.Dimensions {
-	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-		layout.Rigid(mp.LayoutTopBar),
-		layout.Flexed(1, func(gtx C) D {
-			return layout.Stack{Alignment: layout.N}.Layout(gtx,
-				layout.Expanded(func(gtx C) D {
-					currentPage := mp.CurrentPage()
-					if currentPage == nil {
-						return layout.Dimensions{}
-					}
-					return currentPage.Layout(gtx)
-				}),
-				layout.Stacked(func(gtx C) D {
-					return layout.Inset{Bottom: values.MarginPadding20}.Layout(gtx, mp.floatingActionButton.LayoutSendReceive)
+	return layout.Stack{Alignment: layout.NE}.Layout(gtx,
+		layout.Expanded(func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(mp.LayoutTopBar),
+				layout.Flexed(1, func(gtx C) D {
+					return layout.Stack{Alignment: layout.N}.Layout(gtx,
+						layout.Expanded(func(gtx C) D {
+							currentPage := mp.CurrentPage()
+							if currentPage == nil {
+								return layout.Dimensions{}
+							}
+							return currentPage.Layout(gtx)
+						}),
+						layout.Stacked(func(gtx C) D {
+							return layout.Inset{Bottom: values.MarginPadding20}.Layout(gtx, mp.floatingActionButton.LayoutSendReceive)
+						}),
+					)
 				}),
+				layout.Rigid(mp.bottomNavigationBar.LayoutBottomNavigationBar),
 			)
 		}),
-		layout.Rigid(mp.bottomNavigationBar.LayoutBottomNavigationBar),
+		layout.Stacked(mp.taskCentre.layoutPanel),
 	)
 }
 
@@ -729,7 +757,7 @@ func (mp *MainPage) LayoutUSDBalance(gtx layout.Context) layout.Dimensions {
 		return inset.Layout(gtx, func(gtx C) D {
 			return layout.Flex{}.Layout(gtx,
 				layout.Rigid(mp.Theme.Body1("/ ").Layout),
-				layout.Rigid(mp.Theme.Label(values.TextSize20, mp.totalBalanceUSD).Layout),
+				layout.Rigid(mp.Theme.Label(values.TextSize20, mp.MaskAmount(mp.totalBalanceUSD)).Layout),
 			)
 		})
 	default:
@@ -738,7 +766,7 @@ func (mp *MainPage) LayoutUSDBalance(gtx layout.Context) layout.Dimensions {
 }
 
 func (mp *MainPage) totalDCRBalance(gtx C) D {
-	if mp.isBalanceHidden {
+	if mp.IsPrivacyModeOn() {
 		hiddenBalanceText := mp.Theme.Label(values.TextSize18*0.8, "*******************")
 		return layout.Inset{Bottom: values.MarginPadding0, Top: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
 			return hiddenBalanceText.Layout(gtx)
@@ -803,10 +831,13 @@ func (mp *MainPage) LayoutTopBar(gtx layout.Context) layout.Dimensions {
 				layout.Rigid(func(gtx C) D {
 					gtx.Constraints.Min.X = gtx.Constraints.Max.X
 					return layout.E.Layout(gtx, func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+							layout.Rigid(func(gtx C) D {
+								return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, mp.taskCentre.layoutButton)
+							}),
 							layout.Rigid(func(gtx C) D {
 								mp.hideBalanceItem.hideBalanceButton.Icon = mp.Theme.Icons.RevealIcon
-								if mp.isBalanceHidden {
+								if mp.IsPrivacyModeOn() {
 									mp.hideBalanceItem.hideBalanceButton.Icon = mp.Theme.Icons.ConcealIcon
 								}
 								return layout.Inset{
@@ -818,7 +849,7 @@ func (mp *MainPage) LayoutTopBar(gtx layout.Context) layout.Dimensions {
 								return mp.totalDCRBalance(gtx)
 							}),
 							layout.Rigid(func(gtx C) D {
-								if !mp.isBalanceHidden {
+								if !mp.IsPrivacyModeOn() {
 									return mp.LayoutUSDBalance(gtx)
 								}
 								return D{}
@@ -848,10 +879,10 @@ func (mp *MainPage) postDesktopNotification(notifier interface{}) {
 			}
 			// remove trailing zeros from amount and convert to string
 			amount := strconv.FormatFloat(dcrlibwallet.AmountCoin(t.Transaction.Amount), 'f', -1, 64)
-			notification = values.StringF(values.StrDcrReceived, amount)
+			notification = values.StringF(values.StrDcrReceived, mp.MaskAmount(amount))
 		case dcrlibwallet.TxTypeVote:
 			reward := strconv.FormatFloat(dcrlibwallet.AmountCoin(t.Transaction.VoteReward), 'f', -1, 64)
-			notification = values.StringF(values.StrTicektVoted, reward)
+			notification = values.StringF(values.StrTicektVoted, mp.MaskAmount(reward))
 		case dcrlibwallet.TxTypeRevocation:
 			notification = values.String(values.StrTicketRevoked)
 		default:
@@ -900,43 +931,18 @@ func initializeBeepNotification(n string) {
 }
 
 // listenForNotifications starts a goroutine to watch for notifications
-// and update the UI accordingly.
+// and update the UI accordingly. The subscription ends when the page context
+// is canceled.
 func (mp *MainPage) listenForNotifications() {
-	// Return if any of the listener is not nil.
-	switch {
-	case mp.SyncProgressListener != nil:
-		return
-	case mp.TxAndBlockNotificationListener != nil:
-		return
-	case mp.ProposalNotificationListener != nil:
-		return
-	}
-
-	mp.SyncProgressListener = listeners.NewSyncProgress()
-	err := mp.WL.MultiWallet.AddSyncProgressListener(mp.SyncProgressListener, MainPageID)
-	if err != nil {
-		log.Errorf("Error adding sync progress listener: %v", err)
-		return
-	}
-
-	mp.TxAndBlockNotificationListener = listeners.NewTxAndBlockNotificationListener()
-	err = mp.WL.MultiWallet.AddTxAndBlockNotificationListener(mp.TxAndBlockNotificationListener, true, MainPageID)
-	if err != nil {
-		log.Errorf("Error adding tx and block notification listener: %v", err)
-		return
-	}
-
-	mp.ProposalNotificationListener = listeners.NewProposalNotificationListener()
-	err = mp.WL.MultiWallet.Politeia.AddNotificationListener(mp.ProposalNotificationListener, MainPageID)
-	if err != nil {
-		log.Errorf("Error adding politeia notification listener: %v", err)
-		return
-	}
+	sub := mp.Bus.Subscribe(mp.ctx, listeners.Coalesce,
+		listeners.SyncTopic, listeners.TxAndBlockTopic, listeners.ProposalTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-mp.TxAndBlockNotifChan:
+		defer mp.RecoverPanic()
+
+		for event := range sub.Events() {
+			switch n := event.(type) {
+			case listeners.TxNotification:
 				switch n.Type {
 				case listeners.NewTransaction:
 					mp.updateBalance()
@@ -964,35 +970,59 @@ func (mp *MainPage) listenForNotifications() {
 					mp.ParentWindow().Reload()
 
 				}
-			case notification := <-mp.ProposalNotifChan:
+			case wallet.Proposal:
 				// Post desktop notification for all events except the synced event.
-				if notification.ProposalStatus != wallet.Synced {
-					mp.postDesktopNotification(notification)
+				if n.ProposalStatus != wallet.Synced {
+					mp.postDesktopNotification(n)
 				}
-			case n := <-mp.SyncStatusChan:
+			case wallet.SyncStatusUpdate:
 				if n.Stage == wallet.SyncCompleted {
 					mp.updateBalance()
 					mp.ParentWindow().Reload()
 				}
-			case <-mp.ctx.Done():
-				mp.WL.MultiWallet.RemoveSyncProgressListener(MainPageID)
-				mp.WL.MultiWallet.RemoveTxAndBlockNotificationListener(MainPageID)
-				mp.WL.MultiWallet.Politeia.RemoveNotificationListener(MainPageID)
-
-				close(mp.SyncStatusChan)
-				close(mp.TxAndBlockNotifChan)
-				close(mp.ProposalNotifChan)
-
-				mp.SyncProgressListener = nil
-				mp.TxAndBlockNotificationListener = nil
-				mp.ProposalNotificationListener = nil
-
-				return
 			}
 		}
 	}()
 }
 
+// showUncleanShutdownInfo tells the user once that the previous run of the
+// app did not shut down cleanly.
+func (mp *MainPage) showUncleanShutdownInfo(session *wallet.Session) {
+	mp.WL.Wallet.DismissUncleanShutdown()
+	started := values.String(values.StrUnknown)
+	if !session.Started.IsZero() {
+		started = session.Started.Format("Jan 2, 2006 15:04")
+	}
+
+	info := modal.NewInfoModal(mp.Load).
+		Title(values.String(values.StrUncleanShutdown)).
+		Body(values.StringF(values.StrUncleanShutdownInfo, started)).
+		SetCancelable(true).
+		PositiveButton(values.String(values.StrGotIt), func(bool) bool {
+			return true
+		})
+	mp.ParentWindow().ShowModal(info)
+}
+
+// showCrashInfo tells the user that the app crashed in its previous run and
+// offers to show the crash report.
+func (mp *MainPage) showCrashInfo(report *wallet.CrashReport) {
+	if err := mp.WL.Wallet.MarkCrashReportsSeen(); err != nil {
+		log.Errorf("Unable to mark the crash reports seen: %v", err)
+	}
+
+	info := modal.NewInfoModal(mp.Load).
+		Title(values.String(values.StrAppCrashed)).
+		Body(values.StringF(values.StrAppCrashedInfo, report.Time.Format("Jan 2, 2006 15:04"))).
+		SetCancelable(true).
+		NegativeButton(values.String(values.StrNotNow), func() {}).
+		PositiveButton(values.String(values.StrViewReport), func(bool) bool {
+			mp.Display(NewCrashReportPage(mp.Load))
+			return true
+		})
+	mp.ParentWindow().ShowModal(info)
+}
+
 func (mp *MainPage) showBackupInfo() {
 	backupNowOrLaterModal := modal.NewInfoModal(mp.Load).
 		SetupWithTemplate(modal.WalletBackupInfoTemplate).
@@ -0,0 +1,390 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/listeners"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const NetworkPageID = "Network"
+
+// NetworkPage lists the connected peers, edits the persistent peers and shows
+// the connection event log and the sync failures. Peers cannot be banned or disconnected from here:
+// dcrlibwallet keeps its SPV syncer unexported and offers no call for either.
+type NetworkPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	ctx       context.Context // page context
+	ctxCancel context.CancelFunc
+
+	scrollbarList *widget.List
+	backButton    decredmaterial.IconButton
+
+	// listLock protects peers, events and syncFailures, which are refreshed
+	// by the sync notification goroutine.
+	listLock     sync.Mutex
+	peers        []dcrlibwallet.PeerInfo
+	events       []wallet.PeerEvent
+	syncFailures []wallet.SyncFailure
+
+	persistentPeers []string
+	removePeer      []decredmaterial.IconButton
+
+	peerEditor decredmaterial.Editor
+	addPeer    decredmaterial.Button
+
+	clearSyncFailures decredmaterial.Button
+}
+
+func NewNetworkPage(l *load.Load) *NetworkPage {
+	pg := &NetworkPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(NetworkPageID),
+		scrollbarList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		peerEditor: l.Theme.Editor(new(widget.Editor), values.String(values.StrPeerAddress)),
+		addPeer:    l.Theme.Button(values.String(values.StrAddPeer)),
+
+		clearSyncFailures: l.Theme.OutlineButton(values.String(values.StrClear)),
+	}
+	pg.peerEditor.Editor.SingleLine = true
+	pg.peerEditor.Editor.Submit = true
+	pg.addPeer.TextSize = values.TextSize14
+	pg.clearSyncFailures.TextSize = values.TextSize14
+
+	pg.backButton, _ = components.SubpageHeaderButtons(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *NetworkPage) OnNavigatedTo() {
+	pg.ctx, pg.ctxCancel = context.WithCancel(context.TODO())
+	pg.refresh()
+	pg.loadPersistentPeers()
+
+	sub := pg.Bus.Subscribe(pg.ctx, listeners.Coalesce, listeners.SyncTopic)
+	go func() {
+		defer pg.RecoverPanic()
+
+		for range sub.Events() {
+			pg.refresh()
+			pg.ParentWindow().Reload()
+		}
+	}()
+}
+
+func (pg *NetworkPage) refresh() {
+	peers := pg.WL.Wallet.ConnectedPeers()
+	events := pg.WL.Wallet.PeerEvents()
+	syncFailures := pg.WL.Wallet.SyncFailures()
+
+	pg.listLock.Lock()
+	pg.peers, pg.events, pg.syncFailures = peers, events, syncFailures
+	pg.listLock.Unlock()
+}
+
+// lists returns the connected peers and the connection events last
+// refreshed.
+func (pg *NetworkPage) lists() ([]dcrlibwallet.PeerInfo, []wallet.PeerEvent) {
+	pg.listLock.Lock()
+	defer pg.listLock.Unlock()
+	return pg.peers, pg.events
+}
+
+func (pg *NetworkPage) loadPersistentPeers() {
+	pg.persistentPeers = pg.WL.Wallet.PersistentPeers()
+	pg.removePeer = make([]decredmaterial.IconButton, len(pg.persistentPeers))
+	for i := range pg.removePeer {
+		removePeer := pg.Theme.IconButton(pg.Theme.Icons.ContentClear)
+		removePeer.Size = values.MarginPadding20
+		removePeer.Inset = layout.UniformInset(values.MarginPadding4)
+		pg.removePeer[i] = removePeer
+	}
+}
+
+// savePersistentPeers saves peers and restarts an ongoing sync so that it
+// uses them.
+func (pg *NetworkPage) savePersistentPeers(peers []string) error {
+	if err := pg.WL.Wallet.SavePersistentPeers(peers); err != nil {
+		return err
+	}
+	pg.loadPersistentPeers()
+
+	if !pg.WL.MultiWallet.IsSyncing() && !pg.WL.MultiWallet.IsSynced() {
+		pg.Toast.Notify(values.String(values.StrPeersSaved))
+		return nil
+	}
+
+	pg.Toast.Notify(values.String(values.StrPeersSavedRestarting))
+	go func() {
+		if err := pg.WL.Wallet.RestartSync(); err != nil {
+			pg.Toast.NotifyError(components.NetworkErrorText(err))
+		}
+	}()
+	return nil
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *NetworkPage) HandleUserInteractions() {
+	isSubmit, isChanged := decredmaterial.HandleEditorEvents(pg.peerEditor.Editor)
+	if isChanged {
+		pg.peerEditor.SetError("")
+	}
+
+	if pg.addPeer.Clicked() || isSubmit {
+		peers := append(append([]string(nil), pg.persistentPeers...), pg.peerEditor.Editor.Text())
+		if err := pg.savePersistentPeers(peers); err != nil {
+			pg.peerEditor.SetError(err.Error())
+		} else {
+			pg.peerEditor.Editor.SetText("")
+		}
+	}
+
+	if pg.clearSyncFailures.Clicked() {
+		pg.WL.Wallet.ClearSyncFailures()
+		pg.refresh()
+	}
+
+	for i := range pg.removePeer {
+		if pg.removePeer[i].Button.Clicked() {
+			peers := append(append([]string(nil), pg.persistentPeers[:i]...), pg.persistentPeers[i+1:]...)
+			if err := pg.savePersistentPeers(peers); err != nil {
+				pg.Toast.NotifyError(err.Error())
+			}
+			break
+		}
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *NetworkPage) OnNavigatedFrom() {
+	pg.ctxCancel()
+}
+
+// Layout draws the page UI components into the provided layout context
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *NetworkPage) Layout(gtx C) D {
+	container := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrNetworkAndPeers),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: pg.layoutSections,
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView) {
+		return components.UniformMobile(gtx, false, true, container)
+	}
+	return components.UniformPadding(gtx, container)
+}
+
+func (pg *NetworkPage) layoutSections(gtx C) D {
+	sections := []layout.Widget{
+		pg.connectedPeersSection,
+		pg.persistentPeersSection,
+		pg.connectionEventsSection,
+		pg.syncFailuresSection,
+	}
+
+	return pg.Theme.List(pg.scrollbarList).Layout(gtx, len(sections), func(gtx C, i int) D {
+		return layout.Inset{Right: values.MarginPadding2, Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+			return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return layout.UniformInset(values.MarginPadding16).Layout(gtx, sections[i])
+			})
+		})
+	})
+}
+
+func (pg *NetworkPage) sectionTitle(title string) layout.Widget {
+	return func(gtx C) D {
+		txt := pg.Theme.Body1(title)
+		txt.Font.Weight = text.SemiBold
+		return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, txt.Layout)
+	}
+}
+
+func (pg *NetworkPage) grayText(txt string) layout.Widget {
+	lbl := pg.Theme.Body2(txt)
+	lbl.Color = pg.Theme.Color.GrayText2
+	return lbl.Layout
+}
+
+func (pg *NetworkPage) connectedPeersSection(gtx C) D {
+	peers, _ := pg.lists()
+	title := fmt.Sprintf("%s (%d)", values.String(values.StrPeersConnected), len(peers))
+	children := []layout.FlexChild{layout.Rigid(pg.sectionTitle(title))}
+	if len(peers) == 0 {
+		children = append(children, layout.Rigid(pg.grayText(values.String(values.StrNoConnectedPeer))))
+	}
+
+	for _, peer := range peers {
+		peer := peer
+		children = append(children, layout.Rigid(func(gtx C) D {
+			row := func(title, value string) layout.FlexChild {
+				return layout.Rigid(func(gtx C) D {
+					return components.EndToEndRow(gtx, pg.grayText(title), pg.Theme.Body2(value).Layout)
+				})
+			}
+			return layout.Inset{Bottom: values.MarginPadding12}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(pg.Theme.Body1(peer.Addr).Layout),
+					row(values.String(values.StrUserAgent), peer.SubVer),
+					row(values.String(values.StrProtocolVersion), fmt.Sprint(peer.Version)),
+					row(values.String(values.StrStartingHeight), fmt.Sprint(peer.StartingHeight)),
+					row(values.String(values.StrBanScore), fmt.Sprint(peer.BanScore)),
+				)
+			})
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *NetworkPage) persistentPeersSection(gtx C) D {
+	children := []layout.FlexChild{
+		layout.Rigid(pg.sectionTitle(values.String(values.StrPersistentPeers))),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, pg.grayText(values.String(values.StrPersistentPeersInfo)))
+		}),
+	}
+	if len(pg.persistentPeers) == 0 {
+		children = append(children, layout.Rigid(pg.grayText(values.String(values.StrNoPersistentPeers))))
+	}
+
+	for i, peer := range pg.persistentPeers {
+		i, peer := i, peer
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, pg.Theme.Body1(peer).Layout),
+				layout.Rigid(pg.removePeer[i].Layout),
+			)
+		}))
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, pg.peerEditor.Layout),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Left: values.MarginPadding10}.Layout(gtx, pg.addPeer.Layout)
+				}),
+			)
+		})
+	}))
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *NetworkPage) connectionEventsSection(gtx C) D {
+	_, events := pg.lists()
+	children := []layout.FlexChild{layout.Rigid(pg.sectionTitle(values.String(values.StrConnectionEvents)))}
+	if len(events) == 0 {
+		children = append(children, layout.Rigid(pg.grayText(values.String(values.StrNoConnectionEvents))))
+	}
+
+	// Newest events first.
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, pg.grayText(event.Time.Format("2006-01-02 15:04:05")))
+					}),
+					layout.Flexed(1, pg.Theme.Body2(peerEventText(event)).Layout),
+				)
+			})
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *NetworkPage) syncFailuresSection(gtx C) D {
+	pg.listLock.Lock()
+	failures := pg.syncFailures
+	pg.listLock.Unlock()
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			pg.clearSyncFailures.SetEnabled(len(failures) > 0)
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, pg.sectionTitle(values.String(values.StrSyncFailures))),
+				layout.Rigid(pg.clearSyncFailures.Layout),
+			)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, pg.grayText(values.String(values.StrSyncFailuresInfo)))
+		}),
+	}
+	if len(failures) == 0 {
+		children = append(children, layout.Rigid(pg.grayText(values.String(values.StrNoSyncFailures))))
+	}
+
+	// Newest failures first.
+	for i := len(failures) - 1; i >= 0; i-- {
+		failure := failures[i]
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, pg.grayText(failure.Time.Format("2006-01-02 15:04:05")))
+					}),
+					layout.Flexed(1, pg.Theme.Body2(values.StringF(values.StrSyncFailureAttempt, failure.Attempt, failure.Error)).Layout),
+				)
+			})
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func peerEventText(event wallet.PeerEvent) string {
+	switch event.Type {
+	case wallet.PeerConnected:
+		return values.StringF(values.StrPeerConnectedEvent, event.Addr, event.UserAgent)
+	case wallet.PeerDisconnected:
+		return values.StringF(values.StrPeerDisconnectedEvent, event.Addr, event.UserAgent)
+	case wallet.SyncStartedEvent:
+		return values.String(values.StrSyncStartedEvent)
+	case wallet.SyncFailedEvent:
+		return values.StringF(values.StrSyncFailedEvent, event.Error)
+	}
+	return values.String(values.StrSyncStoppedEvent)
+}
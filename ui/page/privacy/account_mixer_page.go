@@ -28,8 +28,6 @@ type AccountMixerPage struct {
 	// and the root WindowNavigator.
 	*app.GenericPageModal
 
-	*listeners.AccountMixerNotificationListener
-
 	ctx       context.Context // page context
 	ctxCancel context.CancelFunc
 
@@ -245,7 +243,10 @@ func (pg *AccountMixerPage) dangerZoneLayout(gtx layout.Context) layout.Dimensio
 // Part of the load.Page interface.
 func (pg *AccountMixerPage) HandleUserInteractions() {
 	if pg.toggleMixer.Changed() {
-		if pg.toggleMixer.IsChecked() {
+		if err := pg.WL.Wallet.FeatureError(wallet.FeatureMixer); pg.toggleMixer.IsChecked() && err != nil {
+			pg.toggleMixer.SetChecked(false)
+			pg.Toast.NotifyError(components.NetworkErrorText(err))
+		} else if pg.toggleMixer.IsChecked() {
 			go pg.showModalPasswordStartAccountMixer()
 		} else {
 			pg.toggleMixer.SetChecked(true)
@@ -311,14 +312,14 @@ func (pg *AccountMixerPage) showModalPasswordStartAccountMixer() {
 		NegativeButton("Cancel", func() {
 			pg.toggleMixer.SetChecked(false)
 		}).
-		PositiveButton("Confirm", func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton("Confirm", func(password []byte, pm *modal.PasswordModal) bool {
 			go func() {
-				err := pg.WL.MultiWallet.StartAccountMixer(pg.WL.SelectedWallet.Wallet.ID, password)
+				err := pg.WL.Wallet.StartAccountMixer(pg.WL.SelectedWallet.Wallet.ID, string(password))
 				if err != nil {
-					pm.SetError(err.Error())
-					pm.SetLoading(false)
+					pm.Failed(err)
 					return
 				}
+				pm.Accepted()
 				pm.Dismiss()
 			}()
 
@@ -328,36 +329,25 @@ func (pg *AccountMixerPage) showModalPasswordStartAccountMixer() {
 }
 
 func (pg *AccountMixerPage) listenForMixerNotifications() {
-	if pg.AccountMixerNotificationListener != nil {
-		return
-	}
-
-	pg.AccountMixerNotificationListener = listeners.NewAccountMixerNotificationListener()
-	err := pg.WL.MultiWallet.AddAccountMixerNotificationListener(pg, AccountMixerPageID)
-	if err != nil {
-		log.Errorf("Error adding account mixer notification listener: %+v", err)
-		return
-	}
+	sub := pg.Bus.Subscribe(pg.ctx, listeners.DropOldest, listeners.MixerTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-pg.MixerChan:
-				if n.RunStatus == wallet.MixerStarted {
-					pg.Toast.Notify("Mixer start Successfully")
-					pg.ParentWindow().Reload()
-				}
+		defer pg.RecoverPanic()
 
-				if n.RunStatus == wallet.MixerEnded {
-					pg.mixerCompleted = true
-					pg.ParentWindow().Reload()
-				}
+		for event := range sub.Events() {
+			n, ok := event.(wallet.AccountMixer)
+			if !ok {
+				continue
+			}
+
+			if n.RunStatus == wallet.MixerStarted {
+				pg.Toast.Notify("Mixer start Successfully")
+				pg.ParentWindow().Reload()
+			}
 
-			case <-pg.ctx.Done():
-				pg.WL.MultiWallet.RemoveAccountMixerNotificationListener(AccountMixerPageID)
-				close(pg.MixerChan)
-				pg.AccountMixerNotificationListener = nil
-				return
+			if n.RunStatus == wallet.MixerEnded {
+				pg.mixerCompleted = true
+				pg.ParentWindow().Reload()
 			}
 		}
 	}()
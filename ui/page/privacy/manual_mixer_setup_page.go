@@ -194,16 +194,16 @@ func (pg *ManualMixerSetupPage) showModalSetupMixerAcct() {
 	passwordModal := modal.NewPasswordModal(pg.Load).
 		Title("Confirm to set mixer accounts").
 		NegativeButton("Cancel", func() {}).
-		PositiveButton("Confirm", func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton("Confirm", func(password []byte, pm *modal.PasswordModal) bool {
 			go func() {
 				mixedAcctNumber := pg.mixedAccountSelector.SelectedAccount().Number
 				unmixedAcctNumber := pg.unmixedAccountSelector.SelectedAccount().Number
-				err := pg.WL.SelectedWallet.Wallet.SetAccountMixerConfig(mixedAcctNumber, unmixedAcctNumber, password)
+				err := pg.WL.SelectedWallet.Wallet.SetAccountMixerConfig(mixedAcctNumber, unmixedAcctNumber, string(password))
 				if err != nil {
-					pm.SetError(err.Error())
-					pm.SetLoading(false)
+					pm.Failed(err)
 					return
 				}
+				pm.Accepted()
 				pg.WL.SelectedWallet.Wallet.SetBoolConfigValueForKey(dcrlibwallet.AccountMixerConfigSet, true)
 
 				// rename mixed account
@@ -82,14 +82,14 @@ func showModalSetupMixerAcct(conf *sharedModalConfig, movefundsChecked bool) {
 	passwordModal := modal.NewPasswordModal(conf.Load).
 		Title("Confirm to create needed accounts").
 		NegativeButton("Cancel", func() {}).
-		PositiveButton("Confirm", func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton("Confirm", func(password []byte, pm *modal.PasswordModal) bool {
 			go func() {
-				err := conf.WL.SelectedWallet.Wallet.CreateMixerAccounts("mixed", "unmixed", password)
+				err := conf.WL.SelectedWallet.Wallet.CreateMixerAccounts("mixed", "unmixed", string(password))
 				if err != nil {
-					pm.SetError(err.Error())
-					pm.SetLoading(false)
+					pm.Failed(err)
 					return
 				}
+				pm.Accepted()
 				conf.WL.SelectedWallet.Wallet.SetBoolConfigValueForKey(dcrlibwallet.AccountMixerConfigSet, true)
 
 				if movefundsChecked {
@@ -113,7 +113,7 @@ func showModalSetupMixerAcct(conf *sharedModalConfig, movefundsChecked bool) {
 
 // moveFundsFromDefaultToUnmixed moves funds from the default wallet account to the
 // newly created unmixed account
-func moveFundsFromDefaultToUnmixed(conf *sharedModalConfig, password string) error {
+func moveFundsFromDefaultToUnmixed(conf *sharedModalConfig, password []byte) error {
 	acc, err := conf.WL.SelectedWallet.Wallet.GetAccountsRaw()
 	if err != nil {
 		return err
@@ -147,7 +147,7 @@ func moveFundsFromDefaultToUnmixed(conf *sharedModalConfig, password string) err
 	}
 
 	// send fund
-	_, err = unsignedTx.Broadcast([]byte(password))
+	_, err = conf.WL.Wallet.Broadcast(sourceAccount.WalletID, unsignedTx, password)
 	if err != nil {
 		return err
 	}
@@ -8,7 +8,6 @@ import (
 	"image/color"
 	"time"
 
-	"gioui.org/io/clipboard"
 	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/op"
@@ -22,6 +21,7 @@ import (
 	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 	qrcode "github.com/yeqown/go-qrcode"
 	"golang.org/x/exp/shiny/materialdesign/icons"
 )
@@ -52,6 +52,8 @@ type ReceivePage struct {
 	ops               *op.Ops
 	selector          *components.AccountSelector
 	copyAddressButton decredmaterial.Button
+	explorer          wallet.BlockExplorer
+	viewOnExplorer    *decredmaterial.Clickable
 
 	backdrop   *widget.Clickable
 	backButton decredmaterial.IconButton
@@ -76,6 +78,7 @@ func NewReceivePage(l *load.Load) *ReceivePage {
 		receiveAddress: l.Theme.Label(values.TextSize20, ""),
 		card:           l.Theme.Card(),
 		backdrop:       new(widget.Clickable),
+		viewOnExplorer: l.Theme.NewClickable(false),
 	}
 
 	pg.info.Inset, pg.info.Size = layout.UniformInset(values.MarginPadding5), values.MarginPadding20
@@ -141,6 +144,7 @@ func NewReceivePage(l *load.Load) *ReceivePage {
 // Part of the load.Page interface.
 func (pg *ReceivePage) OnNavigatedTo() {
 	pg.ctx, pg.ctxCancel = context.WithCancel(context.TODO())
+	pg.explorer = pg.WL.Wallet.BlockExplorer()
 	pg.selector.ListenForTxNotifications(pg.ctx, pg.ParentWindow())
 	pg.selector.SelectFirstWalletValidAccount() // Want to reset the user's selection everytime this page appears?
 	// might be better to track the last selection in a variable and reselect it.
@@ -226,6 +230,7 @@ func (pg *ReceivePage) layoutDesktop(gtx layout.Context) layout.Dimensions {
 
 									return pg.Theme.ImageIcon(gtx, *pg.qrImage, 360)
 								}),
+								layout.Rigid(pg.explorerLinkLayout),
 							)
 						})
 					}),
@@ -299,6 +304,7 @@ func (pg *ReceivePage) layoutMobile(gtx layout.Context) layout.Dimensions {
 									tapToCopy.Color = pg.Theme.Color.Text
 									return tapToCopy.Layout(gtx)
 								}),
+								layout.Rigid(pg.explorerLinkLayout),
 							)
 						})
 					}),
@@ -429,6 +435,25 @@ func (pg *ReceivePage) addressLayout(gtx C) D {
 	})
 }
 
+// explorerLinkLayout draws a link to the current address on the block
+// explorer, or nothing if explorer links are turned off.
+func (pg *ReceivePage) explorerLinkLayout(gtx C) D {
+	if pg.currentAddress == "" || pg.explorer.AddressLink(pg.currentAddress) == "" {
+		return D{}
+	}
+
+	return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+		return pg.viewOnExplorer.Layout(gtx, func(gtx C) D {
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(pg.Theme.Body1(values.StringF(values.StrViewOnExplorer, modal.BlockExplorerName(pg.explorer))).Layout),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, pg.Theme.Icons.RedirectIcon.Layout16dp)
+				}),
+			)
+		})
+	})
+}
+
 // HandleUserInteractions is called just before Layout() to determine
 // if any user interaction recently occurred on the page and may be
 // used to update the page's UI components shortly before they are
@@ -458,6 +483,11 @@ func (pg *ReceivePage) HandleUserInteractions() {
 		pg.isNewAddr = false
 	}
 
+	for pg.viewOnExplorer.Clicked() {
+		explorerURL := pg.explorer.AddressLink(pg.currentAddress)
+		pg.ParentWindow().ShowModal(modal.NewExplorerLinkModal(pg.Load, pg.explorer, explorerURL))
+	}
+
 	if pg.infoButton.Button.Clicked() {
 		info := modal.NewInfoModal(pg.Load).
 			Title(values.String(values.StrReceive)+" DCR").
@@ -492,7 +522,7 @@ generateAddress:
 
 func (pg *ReceivePage) handleCopyEvent(gtx C) {
 	if pg.copy.Clicked() {
-		clipboard.WriteOp{Text: pg.currentAddress}.Add(gtx.Ops)
+		pg.CopyAddress(gtx, pg.currentAddress)
 
 		pg.copy.Text = values.String(values.StrCopied)
 		pg.copy.Color = pg.Theme.Color.Success
@@ -503,7 +533,7 @@ func (pg *ReceivePage) handleCopyEvent(gtx C) {
 	}
 
 	if pg.copyAddressButton.Clicked() {
-		clipboard.WriteOp{Text: pg.copyAddressButton.Text}.Add(gtx.Ops)
+		pg.CopyAddress(gtx, pg.copyAddressButton.Text)
 		pg.Toast.Notify("Copied")
 	}
 }
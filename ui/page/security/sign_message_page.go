@@ -277,15 +277,15 @@ func (pg *SignMessagePage) HandleUserInteractions() {
 			walletPasswordModal := modal.NewPasswordModal(pg.Load).
 				Title(values.String(values.StrConfirmToSign)).
 				NegativeButton(values.String(values.StrCancel), func() {}).
-				PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+				PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 					go func() {
-						sig, err := pg.wallet.SignMessage([]byte(password), address, message)
+						sig, err := pg.wallet.SignMessage(password, address, message)
 						if err != nil {
-							pm.SetError(err.Error())
-							pm.SetLoading(false)
+							pm.Failed(err)
 							return
 						}
 
+						pm.Accepted()
 						pm.Dismiss()
 						pg.signedMessageLabel.Text = dcrlibwallet.EncodeBase64(sig)
 
@@ -0,0 +1,150 @@
+package page
+
+import (
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const SecurityAuditLogPageID = "security_audit_log"
+
+// SecurityAuditLogPage lists failed passphrase attempts and the related
+// security events recorded by the wallet.
+type SecurityAuditLogPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	events    []wallet.SecurityAuditEvent
+	eventList *widget.List
+
+	backButton decredmaterial.IconButton
+}
+
+func NewSecurityAuditLogPage(l *load.Load) *SecurityAuditLogPage {
+	pg := &SecurityAuditLogPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(SecurityAuditLogPageID),
+		eventList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+	}
+
+	pg.backButton, _ = components.SubpageHeaderButtons(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *SecurityAuditLogPage) OnNavigatedTo() {
+	pg.events = pg.WL.Wallet.SecurityAuditLog()
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *SecurityAuditLogPage) HandleUserInteractions() {}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *SecurityAuditLogPage) OnNavigatedFrom() {}
+
+func (pg *SecurityAuditLogPage) eventDescription(event wallet.SecurityAuditEvent) string {
+	scope := values.String(values.StrSpendingPassword)
+	if event.Scope == wallet.PassphraseScopeStartup {
+		scope = values.String(values.StrStartupPassword)
+	}
+
+	switch event.Event {
+	case wallet.AuditEventPassphraseFailed:
+		return values.StringF(values.StrAuditPassphraseFailed, scope, event.Failures)
+	case wallet.AuditEventPassphraseAccepted:
+		return values.StringF(values.StrAuditPassphraseAccepted, scope, event.Failures)
+	case wallet.AuditEventSeedRequired:
+		return values.StringF(values.StrAuditSeedRequired, scope, event.Failures)
+	case wallet.AuditEventSeedVerified:
+		return values.StringF(values.StrAuditSeedVerified, scope, event.Failures)
+	default:
+		return scope + ": " + event.Event
+	}
+}
+
+func (pg *SecurityAuditLogPage) eventRow(gtx C, event wallet.SecurityAuditEvent) D {
+	return layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, pg.Theme.Body1(pg.eventDescription(event)).Layout),
+			layout.Rigid(func(gtx C) D {
+				date := pg.Theme.Body2(event.Time.Format("Jan 2, 2006 15:04:05"))
+				date.Color = pg.Theme.Color.GrayText2
+				return date.Layout(gtx)
+			}),
+		)
+	})
+}
+
+// Layout draws the page UI components into the provided layout context
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *SecurityAuditLogPage) Layout(gtx C) D {
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrSecurityAuditLog),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+					gtx.Constraints.Min.X = gtx.Constraints.Max.X
+					return layout.UniformInset(values.MarginPadding15).Layout(gtx, func(gtx C) D {
+						if len(pg.events) == 0 {
+							label := pg.Theme.Body1(values.String(values.StrNoSecurityEvents))
+							label.Color = pg.Theme.Color.GrayText3
+							return label.Layout(gtx)
+						}
+
+						return pg.Theme.List(pg.eventList).Layout(gtx, len(pg.events), func(gtx C, i int) D {
+							return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+								layout.Rigid(func(gtx C) D {
+									return pg.eventRow(gtx, pg.events[i])
+								}),
+								layout.Rigid(func(gtx C) D {
+									if i == len(pg.events)-1 {
+										return D{}
+									}
+									return pg.Theme.Separator().Layout(gtx)
+								}),
+							)
+						})
+					})
+				})
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView) {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return components.UniformPadding(gtx, body)
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"gioui.org/io/clipboard"
 	"gioui.org/layout"
 	"gioui.org/text"
 	"gioui.org/widget"
@@ -44,6 +43,7 @@ type SaveSeedPage struct {
 	seedList     *widget.List
 	hexLabel     decredmaterial.Label
 	copy         decredmaterial.Button
+	splitSeed    decredmaterial.Button
 
 	infoText   string
 	seed       string
@@ -58,6 +58,7 @@ func NewSaveSeedPage(l *load.Load, wallet *dcrlibwallet.Wallet) *SaveSeedPage {
 		wallet:           wallet,
 		hexLabel:         l.Theme.Label(values.TextSize12, ""),
 		copy:             l.Theme.Button("Copy"),
+		splitSeed:        l.Theme.OutlineButton("Split into shares"),
 		infoText:         "You will be asked to enter the seed word on the next screen.",
 		actionButton:     l.Theme.Button("I have written down all 33 words"),
 		seedList: &widget.List{
@@ -76,6 +77,7 @@ func NewSaveSeedPage(l *load.Load, wallet *dcrlibwallet.Wallet) *SaveSeedPage {
 	pg.backButton.Icon = l.Theme.Icons.ContentClear
 
 	pg.actionButton.Font.Weight = text.Medium
+	pg.splitSeed.TextSize = values.TextSize12
 
 	return pg
 }
@@ -88,15 +90,15 @@ func (pg *SaveSeedPage) OnNavigatedTo() {
 
 	passwordModal := modal.NewPasswordModal(pg.Load).
 		Title("Confirm to show seed").
-		PositiveButton("Confirm", func(password string, m *modal.PasswordModal) bool {
+		PositiveButton("Confirm", func(password []byte, m *modal.PasswordModal) bool {
 			go func() {
-				seed, err := pg.wallet.DecryptSeed([]byte(password))
+				seed, err := pg.wallet.DecryptSeed(password)
 				if err != nil {
-					m.SetLoading(false)
-					m.SetError(err.Error())
+					m.Failed(err)
 					return
 				}
 
+				m.Accepted()
 				m.Dismiss()
 
 				pg.seed = seed
@@ -153,6 +155,12 @@ func (pg *SaveSeedPage) HandleUserInteractions() {
 	for pg.actionButton.Clicked() {
 		pg.ParentNavigator().Display(NewVerifySeedPage(pg.Load, pg.wallet, pg.seed))
 	}
+
+	for pg.splitSeed.Clicked() {
+		if pg.seed != "" {
+			pg.ParentNavigator().Display(NewSeedSharesPage(pg.Load, pg.wallet, pg.seed))
+		}
+	}
 }
 
 // OnNavigatedFrom is called when the page is about to be removed from
@@ -187,11 +195,7 @@ func (pg *SaveSeedPage) layoutDesktop(gtx C) D {
 		Body: func(gtx C) D {
 
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-				layout.Rigid(func(gtx C) D {
-					label := pg.Theme.Label(values.TextSize16, "Write down all 33 words in the correct order.")
-					label.Color = pg.Theme.Color.GrayText1
-					return label.Layout(gtx)
-				}),
+				layout.Rigid(pg.instructionLayout),
 				layout.Flexed(1, func(gtx C) D {
 					label := pg.Theme.Label(values.TextSize14, "Your 33-word seed word")
 					label.Color = pg.Theme.Color.GrayText1
@@ -236,11 +240,7 @@ func (pg *SaveSeedPage) layoutMobile(gtx C) D {
 		},
 		Body: func(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-				layout.Rigid(func(gtx C) D {
-					label := pg.Theme.Label(values.TextSize16, "Write down all 33 words in the correct order.")
-					label.Color = pg.Theme.Color.GrayText1
-					return label.Layout(gtx)
-				}),
+				layout.Rigid(pg.instructionLayout),
 				layout.Rigid(func(gtx C) D {
 					label := pg.Theme.Label(values.TextSize14, "Your 33-word seed word")
 					label.Color = pg.Theme.Color.GrayText1
@@ -273,6 +273,24 @@ func (pg *SaveSeedPage) layoutMobile(gtx C) D {
 	return container(gtx, true, *pg.Theme, layout, pg.infoText, pg.actionButton)
 }
 
+// instructionLayout displays the backup instruction together with the option
+// to split the seed into shares instead of writing down the full seed.
+func (pg *SaveSeedPage) instructionLayout(gtx C) D {
+	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		layout.Flexed(1, func(gtx C) D {
+			label := pg.Theme.Label(values.TextSize16, "Write down all 33 words in the correct order.")
+			label.Color = pg.Theme.Color.GrayText1
+			return label.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			if pg.seed == "" {
+				return D{}
+			}
+			return pg.splitSeed.Layout(gtx)
+		}),
+	)
+}
+
 func (pg *SaveSeedPage) mobileSeedRow(gtx C, row saveSeedRow) D {
 	itemWidth := gtx.Constraints.Max.X / 2 // Divide total width into 2 rows for mobile
 	topMargin := values.MarginPadding8
@@ -347,7 +365,7 @@ func (pg *SaveSeedPage) hexLayout(gtx layout.Context) layout.Dimensions {
 
 func (pg *SaveSeedPage) handleCopyEvent(gtx layout.Context) {
 	if pg.copy.Clicked() {
-		clipboard.WriteOp{Text: pg.hexLabel.Text}.Add(gtx.Ops)
+		pg.CopySensitiveText(gtx, pg.hexLabel.Text)
 
 		pg.copy.Text = "Copied!"
 		pg.copy.Color = pg.Theme.Color.Success
@@ -0,0 +1,227 @@
+package seedbackup
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const SeedSharesPageID = "seed_shares"
+
+// SeedSharesPage splits the wallet seed into N shares of which any M recover
+// the seed, so that no single person has to hold the complete seed.
+type SeedSharesPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	wallet *dcrlibwallet.Wallet
+	seed   string
+	shares []*wallet.SeedShare
+
+	backButton      decredmaterial.IconButton
+	actionButton    decredmaterial.Button
+	generateButton  decredmaterial.Button
+	totalEditor     decredmaterial.Editor
+	thresholdEditor decredmaterial.Editor
+	sharesList      *widget.List
+}
+
+func NewSeedSharesPage(l *load.Load, wallet *dcrlibwallet.Wallet, seed string) *SeedSharesPage {
+	pg := &SeedSharesPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(SeedSharesPageID),
+		wallet:           wallet,
+		seed:             seed,
+		actionButton:     l.Theme.Button("I have written down all shares"),
+		generateButton:   l.Theme.OutlineButton("Generate shares"),
+		sharesList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+	}
+
+	pg.totalEditor = l.Theme.Editor(new(widget.Editor), "Number of shares")
+	pg.totalEditor.Editor.SingleLine, pg.totalEditor.Editor.Submit = true, true
+	pg.totalEditor.Editor.SetText("3")
+
+	pg.thresholdEditor = l.Theme.Editor(new(widget.Editor), "Shares required to restore")
+	pg.thresholdEditor.Editor.SingleLine, pg.thresholdEditor.Editor.Submit = true, true
+	pg.thresholdEditor.Editor.SetText("2")
+
+	pg.actionButton.Font.Weight = text.Medium
+	pg.generateButton.Font.Weight = text.Medium
+
+	pg.backButton, _ = components.SubpageHeaderButtons(l)
+	pg.backButton.Icon = l.Theme.Icons.ContentClear
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *SeedSharesPage) OnNavigatedTo() {}
+
+func (pg *SeedSharesPage) generateShares() {
+	pg.totalEditor.SetError("")
+	pg.thresholdEditor.SetError("")
+
+	total, err := strconv.Atoi(strings.TrimSpace(pg.totalEditor.Editor.Text()))
+	if err != nil || total < 2 || total > wallet.MaxSeedShares {
+		pg.totalEditor.SetError(fmt.Sprintf("Enter a number from 2 to %d", wallet.MaxSeedShares))
+		return
+	}
+
+	threshold, err := strconv.Atoi(strings.TrimSpace(pg.thresholdEditor.Editor.Text()))
+	if err != nil || threshold < 2 || threshold > total {
+		pg.thresholdEditor.SetError(fmt.Sprintf("Enter a number from 2 to %d", total))
+		return
+	}
+
+	shares, err := wallet.SplitSeed(pg.seed, threshold, total)
+	if err != nil {
+		pg.Toast.NotifyError(err.Error())
+		return
+	}
+	pg.shares = shares
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *SeedSharesPage) HandleUserInteractions() {
+	isSubmit, _ := decredmaterial.HandleEditorEvents(pg.totalEditor.Editor, pg.thresholdEditor.Editor)
+	if pg.generateButton.Clicked() || isSubmit {
+		pg.generateShares()
+	}
+
+	for pg.actionButton.Clicked() {
+		if len(pg.shares) > 0 {
+			pg.ParentNavigator().Display(NewVerifySeedSharesPage(pg.Load, pg.wallet, pg.seed, pg.shares[0].Threshold))
+		}
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *SeedSharesPage) OnNavigatedFrom() {}
+
+// Layout draws the page UI components into the provided layout context
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *SeedSharesPage) Layout(gtx C) D {
+	isMobile := pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView)
+	columns := 3
+	if isMobile {
+		columns = 2
+	}
+
+	sp := components.SubPage{
+		Load:       pg.Load,
+		Title:      "Split seed into shares",
+		SubTitle:   "Step 1/2",
+		WalletName: pg.wallet.Name,
+		BackButton: pg.backButton,
+		Back: func() {
+			promptToExit(pg.Load, pg.ParentNavigator(), pg.ParentWindow())
+		},
+		Body: func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					label := pg.Theme.Label(values.TextSize16, "Give each share to a different person. Any of the required number of shares restore the wallet; fewer reveal nothing about the seed.")
+					label.Color = pg.Theme.Color.GrayText1
+					return label.Layout(gtx)
+				}),
+				layout.Rigid(pg.splitOptionsLayout),
+				layout.Flexed(1, func(gtx C) D {
+					return layout.Inset{Bottom: values.MarginPadding120}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.List(pg.sharesList).Layout(gtx, len(pg.shares), func(gtx C, i int) D {
+							return pg.shareLayout(gtx, pg.shares[i], columns)
+						})
+					})
+				}),
+			)
+		},
+	}
+
+	pg.actionButton.SetEnabled(len(pg.shares) > 0)
+	layout := func(gtx C) D {
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+	return container(gtx, isMobile, *pg.Theme, layout, "", pg.actionButton)
+}
+
+func (pg *SeedSharesPage) splitOptionsLayout(gtx C) D {
+	return layout.Inset{Top: values.MarginPadding16, Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, pg.totalEditor.Layout),
+			layout.Rigid(layout.Spacer{Width: values.MarginPadding16}.Layout),
+			layout.Flexed(1, pg.thresholdEditor.Layout),
+			layout.Rigid(layout.Spacer{Width: values.MarginPadding16}.Layout),
+			layout.Rigid(pg.generateButton.Layout),
+		)
+	})
+}
+
+func (pg *SeedSharesPage) shareLayout(gtx C, share *wallet.SeedShare, columns int) D {
+	title := pg.Theme.Label(values.TextSize14, fmt.Sprintf("Share %d of %d · any %d restore the wallet", share.Index, len(pg.shares), share.Threshold))
+	title.Color = pg.Theme.Color.GrayText1
+
+	checksum := pg.Theme.Label(values.TextSize14, fmt.Sprintf("Checksum word: %s", share.Checksum()))
+	checksum.Color = pg.Theme.Color.GrayText2
+
+	rows := make([]layout.FlexChild, 0, len(share.Words)/columns+3)
+	rows = append(rows, layout.Rigid(title.Layout))
+	for start := 0; start < len(share.Words); start += columns {
+		start := start
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			itemWidth := gtx.Constraints.Max.X / columns
+			items := make([]layout.FlexChild, 0, columns)
+			for i := start; i < start+columns && i < len(share.Words); i++ {
+				i := i
+				items = append(items, layout.Rigid(func(gtx C) D {
+					return seedItem(pg.Theme, gtx, itemWidth, i+1, share.Words[i])
+				}))
+			}
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{}.Layout(gtx, items...)
+			})
+		}))
+	}
+	rows = append(rows, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, checksum.Layout)
+	}))
+
+	return decredmaterial.LinearLayout{
+		Width:       decredmaterial.MatchParent,
+		Height:      decredmaterial.WrapContent,
+		Orientation: layout.Vertical,
+		Background:  pg.Theme.Color.Surface,
+		Border:      decredmaterial.Border{Radius: decredmaterial.Radius(8)},
+		Margin:      layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding8},
+		Padding:     layout.UniformInset(values.MarginPadding16),
+	}.Layout(gtx, rows...)
+}
@@ -158,10 +158,10 @@ func (pg *VerifySeedPage) selectedSeedPhrase() string {
 func (pg *VerifySeedPage) verifySeed() {
 	passwordModal := modal.NewPasswordModal(pg.Load).
 		Title("Confirm to verify seed").
-		PositiveButton("Confirm", func(password string, m *modal.PasswordModal) bool {
+		PositiveButton("Confirm", func(password []byte, m *modal.PasswordModal) bool {
 			go func() {
 				seed := pg.selectedSeedPhrase()
-				_, err := pg.WL.MultiWallet.VerifySeedForWallet(pg.wallet.ID, seed, []byte(password))
+				_, err := pg.WL.MultiWallet.VerifySeedForWallet(pg.wallet.ID, seed, password)
 				if err != nil {
 					if err.Error() == dcrlibwallet.ErrInvalid {
 						pg.Toast.NotifyError("Failed to verify. Please go through every word and try again.")
@@ -169,10 +169,10 @@ func (pg *VerifySeedPage) verifySeed() {
 						return
 					}
 
-					m.SetLoading(false)
-					m.SetError(err.Error())
+					m.Failed(err)
 					return
 				}
+				m.Accepted()
 				m.Dismiss()
 
 				pg.ParentNavigator().Display(NewBackupSuccessPage(pg.Load))
@@ -0,0 +1,191 @@
+package seedbackup
+
+import (
+	"fmt"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/modal"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+const VerifySeedSharesPageID = "verify_seed_shares"
+
+// VerifySeedSharesPage has the user type the required number of the shares
+// they wrote down and checks that they restore the wallet seed before the
+// seed is marked as backed up.
+type VerifySeedSharesPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	wallet *dcrlibwallet.Wallet
+	seed   string
+
+	shareEditors []decredmaterial.Editor
+	sharesList   *widget.List
+
+	backButton   decredmaterial.IconButton
+	actionButton decredmaterial.Button
+}
+
+func NewVerifySeedSharesPage(l *load.Load, wallet *dcrlibwallet.Wallet, seed string, threshold int) *VerifySeedSharesPage {
+	pg := &VerifySeedSharesPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(VerifySeedSharesPageID),
+		wallet:           wallet,
+		seed:             seed,
+		actionButton:     l.Theme.Button("Verify"),
+		sharesList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+	}
+
+	for i := 0; i < threshold; i++ {
+		editor := l.Theme.Editor(new(widget.Editor), fmt.Sprintf("Share %d", i+1))
+		editor.Editor.SingleLine, editor.Editor.Submit = false, false
+		pg.shareEditors = append(pg.shareEditors, editor)
+	}
+
+	pg.actionButton.Font.Weight = text.Medium
+
+	pg.backButton, _ = components.SubpageHeaderButtons(l)
+	pg.backButton.Icon = l.Theme.Icons.ContentClear
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *VerifySeedSharesPage) OnNavigatedTo() {}
+
+// enteredShares decodes the entered shares and returns them if every editor
+// holds a valid share.
+func (pg *VerifySeedSharesPage) enteredShares() ([]string, bool) {
+	shares := make([]string, 0, len(pg.shareEditors))
+	for i := range pg.shareEditors {
+		editor := &pg.shareEditors[i]
+		words := strings.TrimSpace(editor.Editor.Text())
+		if words == "" {
+			editor.SetError("")
+			continue
+		}
+
+		if _, err := wallet.DecodeSeedShare(words); err != nil {
+			editor.SetError("Invalid seed share. Check every word.")
+			continue
+		}
+		editor.SetError("")
+		shares = append(shares, words)
+	}
+	return shares, len(shares) == len(pg.shareEditors)
+}
+
+func (pg *VerifySeedSharesPage) verifyShares(shares []string) {
+	seed, err := wallet.CombineSeedShares(shares)
+	if err != nil || seed != pg.seed {
+		pg.Toast.NotifyError("The shares do not restore the wallet seed. Please go through every word and try again.")
+		return
+	}
+
+	passwordModal := modal.NewPasswordModal(pg.Load).
+		Title("Confirm to verify seed").
+		PositiveButton("Confirm", func(password []byte, m *modal.PasswordModal) bool {
+			go func() {
+				_, err := pg.WL.MultiWallet.VerifySeedForWallet(pg.wallet.ID, seed, password)
+				if err != nil {
+					m.Failed(err)
+					return
+				}
+				m.Accepted()
+				m.Dismiss()
+
+				for i := range pg.shareEditors {
+					decredmaterial.WipeEditor(pg.shareEditors[i].Editor)
+				}
+				pg.ParentNavigator().Display(NewBackupSuccessPage(pg.Load))
+			}()
+
+			return false
+		}).
+		NegativeButton("Cancel", func() {})
+	pg.ParentWindow().ShowModal(passwordModal)
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *VerifySeedSharesPage) HandleUserInteractions() {
+	shares, complete := pg.enteredShares()
+	pg.actionButton.SetEnabled(complete)
+
+	for pg.actionButton.Clicked() {
+		if complete {
+			pg.verifyShares(shares)
+		}
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *VerifySeedSharesPage) OnNavigatedFrom() {}
+
+// Layout draws the page UI components into the provided layout context
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *VerifySeedSharesPage) Layout(gtx C) D {
+	isMobile := pg.Load.GetCurrentAppWidth() <= gtx.Dp(values.StartMobileView)
+
+	sp := components.SubPage{
+		Load:       pg.Load,
+		Title:      "Verify seed shares",
+		SubTitle:   "Step 2/2",
+		WalletName: pg.wallet.Name,
+		BackButton: pg.backButton,
+		Back: func() {
+			promptToExit(pg.Load, pg.ParentNavigator(), pg.ParentWindow())
+		},
+		Body: func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					label := pg.Theme.Label(values.TextSize16, fmt.Sprintf("Type any %d of the shares you wrote down, each with its words in order.", len(pg.shareEditors)))
+					label.Color = pg.Theme.Color.GrayText1
+					return label.Layout(gtx)
+				}),
+				layout.Flexed(1, func(gtx C) D {
+					return layout.Inset{Bottom: values.MarginPadding120}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.List(pg.sharesList).Layout(gtx, len(pg.shareEditors), func(gtx C, i int) D {
+							return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.shareEditors[i].Layout)
+						})
+					})
+				}),
+			)
+		},
+	}
+
+	layout := func(gtx C) D {
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+	return container(gtx, isMobile, *pg.Theme, layout, "", pg.actionButton)
+}
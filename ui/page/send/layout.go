@@ -376,9 +376,9 @@ func (pg *Page) toSection(gtx layout.Context) layout.Dimensions {
 
 func (pg *Page) feeSection(gtx layout.Context) layout.Dimensions {
 	collapsibleHeader := func(gtx C) D {
-		feeText := pg.txFee
+		feeText := pg.MaskAmount(pg.txFee)
 		if pg.exchangeRate != -1 && pg.usdExchangeSet {
-			feeText = fmt.Sprintf("%s (%s)", pg.txFee, pg.txFeeUSD)
+			feeText = fmt.Sprintf("%s (%s)", feeText, pg.MaskAmount(pg.txFeeUSD))
 		}
 		return pg.Theme.Body1(feeText).Layout(gtx)
 	}
@@ -441,15 +441,15 @@ func (pg *Page) balanceSection(gtx layout.Context) layout.Dimensions {
 									Bottom: values.MarginPadding10,
 								}
 								return inset.Layout(gtx, func(gtx C) D {
-									totalCostText := pg.totalCost
+									totalCostText := pg.MaskAmount(pg.totalCost)
 									if pg.exchangeRate != -1 && pg.usdExchangeSet {
-										totalCostText = fmt.Sprintf("%s (%s)", pg.totalCost, pg.totalCostUSD)
+										totalCostText = fmt.Sprintf("%s (%s)", totalCostText, pg.MaskAmount(pg.totalCostUSD))
 									}
 									return pg.contentRow(gtx, values.String(values.StrTotalCost), totalCostText)
 								})
 							}),
 							layout.Rigid(func(gtx C) D {
-								return pg.contentRow(gtx, values.String(values.StrBalanceAfter), pg.balanceAfterSend)
+								return pg.contentRow(gtx, values.String(values.StrBalanceAfter), pg.MaskAmount(pg.balanceAfterSend))
 							}),
 						)
 					})
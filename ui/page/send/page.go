@@ -19,6 +19,7 @@ import (
 	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 const (
@@ -168,7 +169,7 @@ func (pg *Page) OnNavigatedTo() {
 	pg.sendDestination.destinationAddressEditor.Editor.Focus()
 
 	currencyExchangeValue := pg.WL.MultiWallet.ReadStringConfigValueForKey(dcrlibwallet.CurrencyConversionConfigKey)
-	if currencyExchangeValue == values.USDExchangeValue {
+	if currencyExchangeValue == values.USDExchangeValue && pg.WL.Wallet.FeatureError(wallet.FeatureExchangeRate) == nil {
 		pg.usdExchangeSet = true
 		go pg.fetchExchangeRate()
 	} else {
@@ -176,6 +177,27 @@ func (pg *Page) OnNavigatedTo() {
 	}
 }
 
+// SetPaymentRequest fills in the destination address and amount of a payment
+// request. The page must be displayed first so that the source account is
+// selected.
+func (pg *Page) SetPaymentRequest(req *wallet.PaymentRequest) {
+	pg.resetFields()
+	pg.clearEstimates()
+
+	pg.sendDestination.accountSwitch.SetSelectedIndex(1)
+	pg.sendDestination.sendToAddress = true
+	pg.sendDestination.destinationAddressEditor.Editor.SetText(req.Address)
+	pg.sendDestination.checkLookalikeAddress()
+	if req.Amount > 0 {
+		pg.amount.setAmount(req.Amount)
+	}
+	pg.validateAndConstructTx()
+
+	if req.Message != "" {
+		pg.Toast.Notify(req.Message)
+	}
+}
+
 // OnDarkModeChanged is triggered whenever the dark mode setting is changed
 // to enable restyling UI elements where necessary.
 // Satisfies the load.DarkModeChangeHandler interface.
@@ -195,7 +217,7 @@ func (pg *Page) fetchExchangeRate() {
 
 	var dcrUsdtBittrex load.DCRUSDTBittrex
 	attempts, err := components.RetryFunc(maxAttempts, delayBtwAttempts, desc, func() error {
-		return load.GetUSDExchangeValue(&dcrUsdtBittrex)
+		return pg.WL.Wallet.GetUSDExchangeValues(&dcrUsdtBittrex)
 	})
 	if err != nil {
 		pg.exchangeRateMessage = "Exchange rate not fetched. Kindly check internet connection."
@@ -9,10 +9,13 @@ import (
 	"gioui.org/widget"
 	"gioui.org/widget/material"
 
+	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type sendConfirmModal struct {
@@ -46,7 +49,7 @@ func newSendConfirmModal(l *load.Load, data *authoredTxData) *sendConfirmModal {
 	scm.confirmButton.SetEnabled(false)
 
 	scm.passwordEditor = l.Theme.EditorPassword(new(widget.Editor), values.String(values.StrSpendingPassword))
-	scm.passwordEditor.Editor.SetText("")
+	decredmaterial.WipeEditor(scm.passwordEditor.Editor)
 	scm.passwordEditor.Editor.SingleLine = true
 	scm.passwordEditor.Editor.Submit = true
 
@@ -57,24 +60,38 @@ func (scm *sendConfirmModal) OnResume() {
 	scm.passwordEditor.Editor.Focus()
 }
 
-func (scm *sendConfirmModal) OnDismiss() {}
+func (scm *sendConfirmModal) OnDismiss() {
+	decredmaterial.WipeEditor(scm.passwordEditor.Editor)
+}
 
 func (scm *sendConfirmModal) broadcastTransaction() {
-	password := scm.passwordEditor.Editor.Text()
-	if password == "" || scm.isSending {
+	if scm.passwordEditor.Editor.Len() == 0 || scm.isSending {
+		return
+	}
+
+	if attemptErr := modal.PassphraseAttemptError(scm.Load, wallet.PassphraseScopeSpending); attemptErr != "" {
+		scm.passwordEditor.SetError(attemptErr)
+		modal.ShowSeedRequiredModal(scm.Load, scm.ParentWindow(), wallet.PassphraseScopeSpending)
 		return
 	}
 
 	scm.isSending = true
 	scm.Modal.SetDisabled(true)
+	password := decredmaterial.EditorBytes(scm.passwordEditor.Editor)
 	go func() {
-		_, err := scm.authoredTxData.txAuthor.Broadcast([]byte(password))
+		_, err := scm.WL.Wallet.Broadcast(scm.authoredTxData.sourceAccount.WalletID, scm.authoredTxData.txAuthor, password)
+		wallet.ZeroBytes(password)
 		scm.isSending = false
 		scm.Modal.SetDisabled(false)
 		if err != nil {
+			if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
+				scm.passwordEditor.SetError(modal.PassphraseAttemptFailed(scm.Load, wallet.PassphraseScopeSpending))
+				return
+			}
 			scm.Toast.NotifyError(err.Error())
 			return
 		}
+		scm.WL.Wallet.ResetPassphraseFailures(wallet.PassphraseScopeSpending, wallet.AuditEventPassphraseAccepted)
 		scm.Toast.Notify(values.String(values.StrTxSent))
 
 		scm.txSent()
@@ -87,7 +104,7 @@ func (scm *sendConfirmModal) Handle() {
 		if scm.passwordEditor.Editor.Focused() {
 			switch evt.(type) {
 			case widget.ChangeEvent:
-				scm.confirmButton.SetEnabled(scm.passwordEditor.Editor.Text() != "")
+				scm.confirmButton.SetEnabled(scm.passwordEditor.Editor.Len() > 0)
 			case widget.SubmitEvent:
 				scm.broadcastTransaction()
 			}
@@ -127,7 +144,7 @@ func (scm *sendConfirmModal) Layout(gtx layout.Context) D {
 								layout.Flexed(1, func(gtx C) D {
 									if scm.exchangeRateSet {
 										return layout.E.Layout(gtx, func(gtx C) D {
-											txt := scm.Theme.Body1(scm.sendAmountUSD)
+											txt := scm.Theme.Body1(scm.MaskAmount(scm.sendAmountUSD))
 											txt.Color = scm.Theme.Color.GrayText2
 											return txt.Layout(gtx)
 										})
@@ -192,18 +209,18 @@ func (scm *sendConfirmModal) Layout(gtx layout.Context) D {
 				}),
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
-						txFeeText := scm.txFee
+						txFeeText := scm.MaskAmount(scm.txFee)
 						if scm.exchangeRateSet {
-							txFeeText = fmt.Sprintf("%s (%s)", scm.txFee, scm.txFeeUSD)
+							txFeeText = fmt.Sprintf("%s (%s)", txFeeText, scm.MaskAmount(scm.txFeeUSD))
 						}
 
 						return scm.contentRow(gtx, values.String(values.StrFee), txFeeText, "")
 					})
 				}),
 				layout.Rigid(func(gtx C) D {
-					totalCostText := scm.totalCost
+					totalCostText := scm.MaskAmount(scm.totalCost)
 					if scm.exchangeRateSet {
-						totalCostText = fmt.Sprintf("%s (%s)", scm.totalCost, scm.totalCostUSD)
+						totalCostText = fmt.Sprintf("%s (%s)", totalCostText, scm.MaskAmount(scm.totalCostUSD))
 					}
 
 					return scm.contentRow(gtx, values.String(values.StrTotalCost), totalCostText, "")
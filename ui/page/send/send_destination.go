@@ -12,6 +12,7 @@ import (
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type destination struct {
@@ -23,6 +24,10 @@ type destination struct {
 
 	sendToAddress bool
 	accountSwitch *decredmaterial.SwitchButtonText
+
+	// lookalikeWarning is shown instead of an error if the destination
+	// address is valid but may have been substituted by clipboard malware.
+	lookalikeWarning string
 }
 
 func newSendDestination(l *load.Load) *destination {
@@ -100,7 +105,7 @@ func (dst *destination) validateDestinationAddress() (bool, string) {
 	}
 
 	if dst.WL.MultiWallet.IsAddressValid(address) {
-		dst.destinationAddressEditor.SetError("")
+		dst.destinationAddressEditor.SetError(dst.lookalikeWarning)
 		return true, address
 	}
 
@@ -120,6 +125,18 @@ func (dst *destination) validate() bool {
 func (dst *destination) clearAddressInput() {
 	dst.destinationAddressEditor.SetError("")
 	dst.destinationAddressEditor.Editor.SetText("")
+	dst.lookalikeWarning = ""
+}
+
+// checkLookalikeAddress warns if the destination address starts and ends like
+// an address recently copied from this app but differs in the middle, which is
+// how clipboard hijacking malware substitutes addresses.
+func (dst *destination) checkLookalikeAddress() {
+	dst.lookalikeWarning = ""
+	address := dst.destinationAddressEditor.Editor.Text()
+	if copiedAddress, ok := wallet.LookalikeAddress(address, dst.CopiedAddresses()); ok {
+		dst.lookalikeWarning = values.StringF(values.StrLookalikeAddress, copiedAddress)
+	}
 }
 
 func (dst *destination) handle() {
@@ -133,6 +150,7 @@ func (dst *destination) handle() {
 		if dst.destinationAddressEditor.Editor.Focused() {
 			switch evt.(type) {
 			case widget.ChangeEvent:
+				dst.checkLookalikeAddress()
 				dst.addressChanged()
 			}
 		}
@@ -3,7 +3,6 @@ package send
 import (
 	"fmt"
 
-	"gioui.org/io/clipboard"
 	"gioui.org/layout"
 	"gioui.org/text"
 	"gioui.org/widget"
@@ -198,13 +197,13 @@ func (pg *UTXOPage) Layout(gtx C) D {
 										return pg.textData(gtx, "Selected:  ", fmt.Sprintf("%d", len(utxos)))
 									}),
 									layout.Flexed(0.25, func(gtx C) D {
-										return pg.textData(gtx, "Amount:  ", pg.txnAmount)
+										return pg.textData(gtx, "Amount:  ", pg.MaskAmount(pg.txnAmount))
 									}),
 									layout.Flexed(0.25, func(gtx C) D {
-										return pg.textData(gtx, "Fee:  ", pg.txnFee)
+										return pg.textData(gtx, "Fee:  ", pg.MaskAmount(pg.txnFee))
 									}),
 									layout.Flexed(0.25, func(gtx C) D {
-										return pg.textData(gtx, "After Fee:  ", pg.txnAmountAfterFee)
+										return pg.textData(gtx, "After Fee:  ", pg.MaskAmount(pg.txnAmountAfterFee))
 									}),
 								)
 							})
@@ -279,7 +278,7 @@ func (pg *UTXOPage) utxoRow(gtx C, data *wallet.UnspentOutput, index int) D {
 	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
 		layout.Rigid(pg.checkboxes[index].Layout),
 		layout.Rigid(func(gtx C) D {
-			txt := pg.Theme.Body2(data.Amount)
+			txt := pg.Theme.Body2(pg.MaskAmount(data.Amount))
 			txt.MaxLines = 1
 			txt.Alignment = text.Start
 			gtx.Constraints.Min.X = gtx.Dp(values.MarginPadding150)
@@ -308,7 +307,7 @@ func (pg *UTXOPage) utxoRow(gtx C, data *wallet.UnspentOutput, index int) D {
 		}),
 		layout.Rigid(func(gtx C) D {
 			if pg.copyButtons[index].Button.Clicked() {
-				clipboard.WriteOp{Text: data.UTXO.Addresses}.Add(gtx.Ops)
+				pg.CopyAddress(gtx, data.UTXO.Addresses)
 			}
 			return layout.Inset{Left: values.MarginPadding10}.Layout(gtx, pg.copyButtons[index].Layout)
 		}),
@@ -1,6 +1,8 @@
 package page
 
 import (
+	"strings"
+
 	"gioui.org/layout"
 	"gioui.org/widget"
 
@@ -38,8 +40,16 @@ type SettingsPage struct {
 	updateConnectToPeer *decredmaterial.Clickable
 	updateUserAgent     *decredmaterial.Clickable
 	changeStartupPass   *decredmaterial.Clickable
+	autoLock            *decredmaterial.Clickable
+	clearClipboard      *decredmaterial.Clickable
+	minPasswordScore    *decredmaterial.Clickable
+	securityAuditLog    *decredmaterial.Clickable
 	language            *decredmaterial.Clickable
 	currency            *decredmaterial.Clickable
+	blockExplorer       *decredmaterial.Clickable
+	proxy               *decredmaterial.Clickable
+	syncStallTimeout    *decredmaterial.Clickable
+	syncPolicy          *decredmaterial.Clickable
 
 	chevronRightIcon *decredmaterial.Icon
 	backButton       decredmaterial.IconButton
@@ -48,6 +58,10 @@ type SettingsPage struct {
 	isDarkModeOn            *decredmaterial.Switch
 	spendUnconfirmed        *decredmaterial.Switch
 	startupPassword         *decredmaterial.Switch
+	seedAfterFailures       *decredmaterial.Switch
+	privacyMode             *decredmaterial.Switch
+	offlineMode             *decredmaterial.Switch
+	privacyOnFocusLoss      *decredmaterial.Switch
 	beepNewBlocks           *decredmaterial.Switch
 	connectToPeer           *decredmaterial.Switch
 	userAgent               *decredmaterial.Switch
@@ -77,6 +91,10 @@ func NewSettingsPage(l *load.Load) *SettingsPage {
 		isDarkModeOn:            l.Theme.Switch(),
 		spendUnconfirmed:        l.Theme.Switch(),
 		startupPassword:         l.Theme.Switch(),
+		seedAfterFailures:       l.Theme.Switch(),
+		privacyMode:             l.Theme.Switch(),
+		offlineMode:             l.Theme.Switch(),
+		privacyOnFocusLoss:      l.Theme.Switch(),
 		beepNewBlocks:           l.Theme.Switch(),
 		connectToPeer:           l.Theme.Switch(),
 		userAgent:               l.Theme.Switch(),
@@ -91,8 +109,16 @@ func NewSettingsPage(l *load.Load) *SettingsPage {
 		updateConnectToPeer: l.Theme.NewClickable(false),
 		updateUserAgent:     l.Theme.NewClickable(false),
 		changeStartupPass:   l.Theme.NewClickable(false),
+		autoLock:            l.Theme.NewClickable(false),
+		clearClipboard:      l.Theme.NewClickable(false),
+		minPasswordScore:    l.Theme.NewClickable(false),
+		securityAuditLog:    l.Theme.NewClickable(false),
 		language:            l.Theme.NewClickable(false),
 		currency:            l.Theme.NewClickable(false),
+		blockExplorer:       l.Theme.NewClickable(false),
+		proxy:               l.Theme.NewClickable(false),
+		syncStallTimeout:    l.Theme.NewClickable(false),
+		syncPolicy:          l.Theme.NewClickable(false),
 	}
 
 	pg.backButton, pg.infoButton = components.SubpageHeaderButtons(l)
@@ -188,6 +214,15 @@ func (pg *SettingsPage) general() layout.Widget {
 				layout.Rigid(func(gtx C) D {
 					return pg.subSectionSwitch(gtx, values.String(values.StrGovernance), pg.governance)
 				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.subSectionSwitch(gtx, values.String(values.StrPrivacyMode), pg.privacyMode)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.subSectionSwitch(gtx, values.String(values.StrOfflineMode), pg.offlineMode)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.subSectionSwitch(gtx, values.String(values.StrPrivacyModeOnFocusLoss), pg.privacyOnFocusLoss)
+				}),
 				layout.Rigid(pg.lineSeparator()),
 				layout.Rigid(func(gtx C) D {
 					currencyConversionRow := row{
@@ -199,6 +234,16 @@ func (pg *SettingsPage) general() layout.Widget {
 					return pg.clickableRow(gtx, currencyConversionRow)
 				}),
 				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					blockExplorerRow := row{
+						title:     values.String(values.StrBlockExplorer),
+						clickable: pg.blockExplorer,
+						icon:      pg.chevronRightIcon,
+						label:     pg.Theme.Body2(modal.BlockExplorerName(pg.wal.BlockExplorer())),
+					}
+					return pg.clickableRow(gtx, blockExplorerRow)
+				}),
+				layout.Rigid(pg.lineSeparator()),
 				layout.Rigid(func(gtx C) D {
 					languageRow := row{
 						title:     values.String(values.StrLanguage),
@@ -249,6 +294,64 @@ func (pg *SettingsPage) security() layout.Widget {
 						return pg.clickableRow(gtx, changeStartupPassRow)
 					})
 				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.conditionalDisplay(gtx, pg.isStartupPassword, func(gtx C) D {
+						autoLockTimeout := pg.WL.MultiWallet.ReadStringConfigValueForKey(load.AutoLockTimeoutConfigKey)
+						if _, ok := values.ArrAutoLockTimeouts[autoLockTimeout]; !ok {
+							autoLockTimeout = values.DefaultAutoLockTimeout
+						}
+						autoLockRow := row{
+							title:     values.String(values.StrAutoLock),
+							clickable: pg.autoLock,
+							icon:      pg.chevronRightIcon,
+							label:     pg.Theme.Body2(values.String(values.ArrAutoLockTimeouts[autoLockTimeout])),
+						}
+						return pg.clickableRow(gtx, autoLockRow)
+					})
+				}),
+				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					clearDelay := pg.WL.MultiWallet.ReadStringConfigValueForKey(load.ClipboardClearDelayConfigKey)
+					if _, ok := values.ArrClipboardDelays[clearDelay]; !ok {
+						clearDelay = values.DefaultClipboardClearDelay
+					}
+					clearClipboardRow := row{
+						title:     values.String(values.StrClearClipboard),
+						clickable: pg.clearClipboard,
+						icon:      pg.chevronRightIcon,
+						label:     pg.Theme.Body2(values.String(values.ArrClipboardDelays[clearDelay])),
+					}
+					return pg.clickableRow(gtx, clearClipboardRow)
+				}),
+				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					minScore := pg.WL.MultiWallet.ReadStringConfigValueForKey(load.MinPasswordScoreConfigKey)
+					if _, ok := values.ArrMinPasswordScores[minScore]; !ok {
+						minScore = values.DefaultMinPasswordScore
+					}
+					minPasswordScoreRow := row{
+						title:     values.String(values.StrMinPasswordStrength),
+						clickable: pg.minPasswordScore,
+						icon:      pg.chevronRightIcon,
+						label:     pg.Theme.Body2(values.String(values.ArrMinPasswordScores[minScore])),
+					}
+					return pg.clickableRow(gtx, minPasswordScoreRow)
+				}),
+				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					title := values.StringF(values.StrSeedAfterPassphraseFailures, wallet.SeedRequiredPassphraseFailures)
+					return pg.subSectionSwitch(gtx, title, pg.seedAfterFailures)
+				}),
+				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					securityAuditLogRow := row{
+						title:     values.String(values.StrSecurityAuditLog),
+						clickable: pg.securityAuditLog,
+						icon:      pg.chevronRightIcon,
+						label:     pg.Theme.Body1(""),
+					}
+					return pg.clickableRow(gtx, securityAuditLogRow)
+				}),
 			)
 		})
 	}
@@ -275,6 +378,54 @@ func (pg *SettingsPage) connection() layout.Widget {
 					})
 				}),
 				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					proxyConfig := pg.wal.ProxyConfig()
+					proxyRow := row{
+						title:     values.String(values.StrProxy),
+						clickable: pg.proxy,
+						icon:      pg.chevronRightIcon,
+						label:     pg.Theme.Body2(modal.ProxyName(proxyConfig)),
+					}
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+						layout.Rigid(func(gtx C) D {
+							return pg.clickableRow(gtx, proxyRow)
+						}),
+						layout.Rigid(func(gtx C) D {
+							// dcrlibwallet does not connect through the proxy.
+							if !proxyConfig.Enabled() || proxyConfig.TorOnly {
+								return D{}
+							}
+							txt := pg.Theme.Body2(values.String(values.StrProxyDirectConnections))
+							txt.Color = pg.Theme.Color.GrayText2
+							return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, txt.Layout)
+						}),
+					)
+				}),
+				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					stallTimeout := pg.WL.MultiWallet.ReadStringConfigValueForKey(load.SyncStallTimeoutConfigKey)
+					if _, ok := values.ArrSyncStallTimeouts[stallTimeout]; !ok {
+						stallTimeout = values.DefaultSyncStallTimeout
+					}
+					syncStallTimeoutRow := row{
+						title:     values.String(values.StrSyncStallTimeout),
+						clickable: pg.syncStallTimeout,
+						icon:      pg.chevronRightIcon,
+						label:     pg.Theme.Body2(values.String(values.ArrSyncStallTimeouts[stallTimeout])),
+					}
+					return pg.clickableRow(gtx, syncStallTimeoutRow)
+				}),
+				layout.Rigid(pg.lineSeparator()),
+				layout.Rigid(func(gtx C) D {
+					syncPolicyRow := row{
+						title:     values.String(values.StrSyncPolicy),
+						clickable: pg.syncPolicy,
+						icon:      pg.chevronRightIcon,
+						label:     pg.Theme.Body2(modal.SyncPolicyName(pg.wal.SyncPolicy())),
+					}
+					return pg.clickableRow(gtx, syncPolicyRow)
+				}),
+				layout.Rigid(pg.lineSeparator()),
 				layout.Rigid(pg.agent()),
 			)
 		})
@@ -450,6 +601,84 @@ func (pg *SettingsPage) HandleUserInteractions() {
 		break
 	}
 
+	for pg.proxy.Clicked() {
+		pg.ParentWindow().ShowModal(modal.NewProxyModal(pg.Load))
+		break
+	}
+
+	for pg.syncStallTimeout.Clicked() {
+		syncStallTimeoutSelectorModal := preference.NewListPreference(pg.Load,
+			load.SyncStallTimeoutConfigKey, values.DefaultSyncStallTimeout,
+			values.ArrSyncStallTimeouts).
+			Title(values.StrSyncStallTimeout).
+			UpdateValues(func() {})
+		pg.ParentWindow().ShowModal(syncStallTimeoutSelectorModal)
+		break
+	}
+
+	for pg.syncPolicy.Clicked() {
+		pg.ParentWindow().ShowModal(modal.NewSyncPolicyModal(pg.Load))
+		break
+	}
+
+	for pg.blockExplorer.Clicked() {
+		pg.ParentWindow().ShowModal(modal.NewBlockExplorerModal(pg.Load))
+		break
+	}
+
+	for pg.autoLock.Clicked() {
+		autoLockSelectorModal := preference.NewListPreference(pg.Load,
+			load.AutoLockTimeoutConfigKey, values.DefaultAutoLockTimeout,
+			values.ArrAutoLockTimeouts).
+			Title(values.StrAutoLock).
+			UpdateValues(func() {})
+		pg.ParentWindow().ShowModal(autoLockSelectorModal)
+		break
+	}
+
+	for pg.clearClipboard.Clicked() {
+		clearClipboardSelectorModal := preference.NewListPreference(pg.Load,
+			load.ClipboardClearDelayConfigKey, values.DefaultClipboardClearDelay,
+			values.ArrClipboardDelays).
+			Title(values.StrClearClipboard).
+			UpdateValues(func() {})
+		pg.ParentWindow().ShowModal(clearClipboardSelectorModal)
+		break
+	}
+
+	for pg.minPasswordScore.Clicked() {
+		minPasswordScoreSelectorModal := preference.NewListPreference(pg.Load,
+			load.MinPasswordScoreConfigKey, values.DefaultMinPasswordScore,
+			values.ArrMinPasswordScores).
+			Title(values.StrMinPasswordStrength).
+			UpdateValues(func() {})
+		pg.ParentWindow().ShowModal(minPasswordScoreSelectorModal)
+		break
+	}
+
+	for pg.securityAuditLog.Clicked() {
+		pg.ParentNavigator().Display(NewSecurityAuditLogPage(pg.Load))
+		break
+	}
+
+	if pg.seedAfterFailures.Changed() {
+		pg.WL.MultiWallet.SaveUserConfigValue(load.SeedAfterPassphraseFailuresKey, pg.seedAfterFailures.IsChecked())
+	}
+
+	if pg.privacyMode.Changed() {
+		pg.SetPrivacyMode(pg.privacyMode.IsChecked())
+	}
+	// Privacy mode may also be toggled with a keyboard shortcut.
+	pg.privacyMode.SetChecked(pg.IsPrivacyModeOn())
+
+	if pg.offlineMode.Changed() {
+		pg.wal.SetOfflineMode(pg.offlineMode.IsChecked())
+	}
+
+	if pg.privacyOnFocusLoss.Changed() {
+		pg.WL.MultiWallet.SaveUserConfigValue(load.PrivacyOnFocusLossConfigKey, pg.privacyOnFocusLoss.IsChecked())
+	}
+
 	if pg.isDarkModeOn.Changed() {
 		pg.WL.MultiWallet.SaveUserConfigValue(load.DarkModeConfigKey, pg.isDarkModeOn.IsChecked())
 		pg.RefreshTheme(pg.ParentWindow())
@@ -460,8 +689,11 @@ func (pg *SettingsPage) HandleUserInteractions() {
 	}
 
 	if pg.governance.Changed() {
-		if pg.governance.IsChecked() {
-			go pg.WL.MultiWallet.Politeia.Sync()
+		if err := pg.wal.FeatureError(wallet.FeatureGovernance); pg.governance.IsChecked() && err != nil {
+			pg.governance.SetChecked(false)
+			pg.Toast.NotifyError(components.NetworkErrorText(err))
+		} else if pg.governance.IsChecked() {
+			go pg.WL.Wallet.SyncPoliteia()
 			pg.WL.MultiWallet.SaveUserConfigValue(load.FetchProposalConfigKey, pg.governance.IsChecked())
 			pg.Toast.Notify(values.StringF(values.StrPropFetching, values.String(values.StrEnabled), values.String(values.StrCheckGovernace)))
 		} else {
@@ -519,21 +751,19 @@ func (pg *SettingsPage) HandleUserInteractions() {
 		currentPasswordModal := modal.NewPasswordModal(pg.Load).
 			Title(values.String(values.StrConfirmStartupPass)).
 			Hint(values.String(values.StrCurrentStartupPass)).
+			PassphraseScope(wallet.PassphraseScopeStartup).
 			NegativeButton(values.String(values.StrCancel), func() {}).
-			PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+			PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 				go func() {
-					var error string
-					err := pg.wal.GetMultiWallet().VerifyStartupPassphrase([]byte(password))
+					err := pg.wal.GetMultiWallet().VerifyStartupPassphrase(password)
 					if err != nil {
-						if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
-							error = values.String(values.StrInvalidPassphrase)
-						} else {
-							error = err.Error()
-						}
-						pm.SetError(error)
-						pm.SetLoading(false)
+						pm.Failed(err)
 						return
 					}
+					pm.Accepted()
+					// password is zeroed when this modal is dismissed but is
+					// needed to change the password.
+					currentPassword := append([]byte(nil), password...)
 					pm.Dismiss()
 
 					// change password
@@ -542,14 +772,18 @@ func (pg *SettingsPage) HandleUserInteractions() {
 						EnableName(false).
 						PasswordHint(values.String(values.StrNewStartupPass)).
 						ConfirmPasswordHint(values.String(values.StrConfirmNewStartupPass)).
-						PasswordCreated(func(walletName, newPassword string, m *modal.CreatePasswordModal) bool {
+						NegativeButton(func() {
+							wallet.ZeroBytes(currentPassword)
+						}).
+						PasswordCreated(func(walletName string, newPassword []byte, m *modal.CreatePasswordModal) bool {
 							go func() {
-								err := pg.wal.GetMultiWallet().ChangeStartupPassphrase([]byte(password), []byte(newPassword), dcrlibwallet.PassphraseTypePass)
+								err := pg.wal.GetMultiWallet().ChangeStartupPassphrase(currentPassword, newPassword, dcrlibwallet.PassphraseTypePass)
 								if err != nil {
 									m.SetError(err.Error())
 									m.SetLoading(false)
 									return
 								}
+								wallet.ZeroBytes(currentPassword)
 								pg.Toast.Notify(values.String(values.StrStartupPassConfirm))
 								m.Dismiss()
 							}()
@@ -572,9 +806,9 @@ func (pg *SettingsPage) HandleUserInteractions() {
 				PasswordHint(values.String(values.StrStartupPassword)).
 				ConfirmPasswordHint(values.String(values.StrConfirmStartupPass)).
 				NegativeButton(func() {}).
-				PasswordCreated(func(walletName, password string, m *modal.CreatePasswordModal) bool {
+				PasswordCreated(func(walletName string, password []byte, m *modal.CreatePasswordModal) bool {
 					go func() {
-						err := pg.wal.GetMultiWallet().SetStartupPassphrase([]byte(password), dcrlibwallet.PassphraseTypePass)
+						err := pg.wal.GetMultiWallet().SetStartupPassphrase(password, dcrlibwallet.PassphraseTypePass)
 						if err != nil {
 							m.SetError(err.Error())
 							m.SetLoading(false)
@@ -590,21 +824,16 @@ func (pg *SettingsPage) HandleUserInteractions() {
 			currentPasswordModal := modal.NewPasswordModal(pg.Load).
 				Title(values.String(values.StrConfirmRemoveStartupPass)).
 				Hint(values.String(values.StrStartupPassword)).
+				PassphraseScope(wallet.PassphraseScopeStartup).
 				NegativeButton(values.String(values.StrCancel), func() {}).
-				PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+				PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 					go func() {
-						var error string
-						err := pg.wal.GetMultiWallet().RemoveStartupPassphrase([]byte(password))
+						err := pg.wal.GetMultiWallet().RemoveStartupPassphrase(password)
 						if err != nil {
-							if err.Error() == dcrlibwallet.ErrInvalidPassphrase {
-								error = values.String(values.StrInvalidPassphrase)
-							} else {
-								error = err.Error()
-							}
-							pm.SetError(error)
-							pm.SetLoading(false)
+							pm.Failed(err)
 							return
 						}
+						pm.Accepted()
 						pg.Toast.Notify(values.StringF(values.StrStartupPasswordEnabled, values.String(values.StrDisabled)))
 						pm.Dismiss()
 					}()
@@ -665,8 +894,13 @@ func (pg *SettingsPage) showSPVPeerDialog() {
 		Hint(values.String(values.StrIPAddress)).
 		PositiveButtonStyle(pg.Load.Theme.Color.Primary, pg.Load.Theme.Color.InvText).
 		PositiveButton(values.String(values.StrConfirm), func(ipAddress string, tim *modal.TextInputModal) bool {
-			if ipAddress != "" {
-				pg.WL.MultiWallet.SaveUserConfigValue(dcrlibwallet.SpvPersistentPeerAddressesConfigKey, ipAddress)
+			if ipAddress == "" {
+				return true
+			}
+			if err := pg.wal.SavePersistentPeers(strings.Split(ipAddress, ";")); err != nil {
+				tim.SetError(err.Error())
+				tim.SetLoading(false)
+				return false
 			}
 			return true
 		})
@@ -707,6 +941,10 @@ func (pg *SettingsPage) updateSettingOptions() {
 		pg.isDarkModeOn.SetChecked(isDarkModeOn)
 	}
 
+	pg.seedAfterFailures.SetChecked(pg.WL.MultiWallet.ReadBoolConfigValueForKey(load.SeedAfterPassphraseFailuresKey, false))
+	pg.privacyOnFocusLoss.SetChecked(pg.WL.MultiWallet.ReadBoolConfigValueForKey(load.PrivacyOnFocusLossConfigKey, false))
+	pg.offlineMode.SetChecked(pg.wal.OfflineMode())
+
 	isSpendUnconfirmed := pg.WL.MultiWallet.ReadBoolConfigValueForKey(dcrlibwallet.SpendUnconfirmedConfigKey, false)
 	pg.spendUnconfirmed.SetChecked(false)
 	if isSpendUnconfirmed {
@@ -174,10 +174,10 @@ func (pg *Page) balanceProgressBarLayout(gtx C) D {
 		return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
 			return layout.Flex{}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
-					return pg.layoutIconAndText(gtx, "Staked"+": ", totalBalance.LockedByTickets.String(), items[0].Color)
+					return pg.layoutIconAndText(gtx, "Staked"+": ", pg.MaskAmount(totalBalance.LockedByTickets.String()), items[0].Color)
 				}),
 				layout.Rigid(func(gtx C) D {
-					return pg.layoutIconAndText(gtx, values.String(values.StrLabelSpendable)+": ", totalBalance.Spendable.String(), items[1].Color)
+					return pg.layoutIconAndText(gtx, values.String(values.StrLabelSpendable)+": ", pg.MaskAmount(totalBalance.Spendable.String()), items[1].Color)
 				}),
 			)
 		})
@@ -214,7 +214,7 @@ func (pg *Page) layoutIconAndText(gtx C, title string, val string, col color.NRG
 
 func (pg *Page) stakingRecordStatistics(gtx C) D {
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-		layout.Rigid(pg.stakingRecord(pg.totalRewards, fmt.Sprintf("%s %s", values.String(values.StrTotal), values.String(values.StrReward)))),
+		layout.Rigid(pg.stakingRecord(pg.MaskAmount(pg.totalRewards), fmt.Sprintf("%s %s", values.String(values.StrTotal), values.String(values.StrReward)))),
 		layout.Rigid(pg.stakingRecord(fmt.Sprintf("%d", pg.ticketOverview.Voted), values.String(values.StrVoted))),
 		layout.Rigid(pg.stakingRecord(fmt.Sprintf("%d", pg.ticketOverview.Revoked), values.String(values.StrRevoked))),
 		layout.Rigid(pg.stakingRecord(fmt.Sprintf("%d", pg.ticketOverview.Immature), values.String(values.StrImmature))),
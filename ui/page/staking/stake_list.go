@@ -14,30 +14,16 @@ func (pg *Page) initTicketList() {
 }
 
 func (pg *Page) listenForTxNotifications() {
-	if pg.TxAndBlockNotificationListener != nil {
-		return
-	}
-	pg.TxAndBlockNotificationListener = listeners.NewTxAndBlockNotificationListener()
-	err := pg.WL.MultiWallet.AddTxAndBlockNotificationListener(pg.TxAndBlockNotificationListener, true, OverviewPageID)
-	if err != nil {
-		log.Errorf("Error adding tx and block notification listener: %v", err)
-		return
-	}
+	sub := pg.Bus.Subscribe(pg.ctx, listeners.DropOldest, listeners.TxAndBlockTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-pg.TxAndBlockNotifChan:
-				if n.Type == listeners.BlockAttached || n.Type == listeners.NewTransaction {
-					pg.fetchTickets()
-					pg.ParentWindow().Reload()
-				}
-			case <-pg.ctx.Done():
-				pg.WL.MultiWallet.RemoveTxAndBlockNotificationListener(OverviewPageID)
-				close(pg.TxAndBlockNotifChan)
-				pg.TxAndBlockNotificationListener = nil
+		defer pg.RecoverPanic()
 
-				return
+		for event := range sub.Events() {
+			n, ok := event.(listeners.TxNotification)
+			if ok && (n.Type == listeners.BlockAttached || n.Type == listeners.NewTransaction) {
+				pg.fetchTickets()
+				pg.ParentWindow().Reload()
 			}
 		}
 	}()
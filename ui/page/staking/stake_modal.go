@@ -13,6 +13,7 @@ import (
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type ticketBuyerModal struct {
@@ -67,7 +68,7 @@ func (tb *ticketBuyerModal) OnResume() {
 	tb.ctx, tb.ctxCancel = context.WithCancel(context.TODO())
 	tb.accountSelector.ListenForTxNotifications(tb.ctx, tb.ParentWindow())
 
-	if len(tb.WL.MultiWallet.KnownVSPs()) == 0 {
+	if len(tb.WL.MultiWallet.KnownVSPs()) == 0 && tb.WL.Wallet.FeatureError(wallet.FeatureVSP) == nil {
 		// TODO: Does this modal need this list?
 		go tb.WL.MultiWallet.ReloadVSPList(context.TODO())
 	}
@@ -11,13 +11,13 @@ import (
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/app"
-	"github.com/planetdecred/godcr/listeners"
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	tpage "github.com/planetdecred/godcr/ui/page/transaction"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 type (
@@ -34,7 +34,6 @@ type Page struct {
 	// helper methods for accessing the PageNavigator that displayed this page
 	// and the root WindowNavigator.
 	*app.GenericPageModal
-	*listeners.TxAndBlockNotificationListener
 
 	list *widget.List
 
@@ -114,7 +113,7 @@ func (pg *Page) setStakingButtonsState() {
 
 func (pg *Page) loadPageData() {
 	go func() {
-		if len(pg.WL.MultiWallet.KnownVSPs()) == 0 {
+		if len(pg.WL.MultiWallet.KnownVSPs()) == 0 && pg.WL.Wallet.FeatureError(wallet.FeatureVSP) == nil {
 			// TODO: Does this page need this list?
 			if pg.ctx != nil {
 				pg.WL.MultiWallet.ReloadVSPList(pg.ctx)
@@ -201,7 +200,10 @@ func (pg *Page) HandleUserInteractions() {
 	pg.setStakingButtonsState()
 
 	if pg.stake.Changed() && pg.stake.IsChecked() {
-		if pg.WL.SelectedWallet.Wallet.TicketBuyerConfigIsSet() {
+		if err := pg.WL.Wallet.FeatureError(wallet.FeatureTicketBuyer); err != nil {
+			pg.stake.SetChecked(false)
+			pg.Toast.NotifyError(components.NetworkErrorText(err))
+		} else if pg.WL.SelectedWallet.Wallet.TicketBuyerConfigIsSet() {
 			// get ticket buyer config to check if the saved wallet account is mixed
 			//check if mixer is set, if yes check if allow spend from unmixed account
 			//if not set, check if the saved account is mixed before opening modal
@@ -259,7 +261,7 @@ func (pg *Page) HandleUserInteractions() {
 		// wallet passphrase should be requested and used to unlock
 		// the wallet before calling this method.
 		// TODO: Use log.Errorf and log.Warnf instead of fmt.Printf.
-		ticketInfo, err := pg.WL.MultiWallet.VSPTicketInfo(ticketTx.WalletID, ticketTx.Hash)
+		ticketInfo, err := pg.WL.Wallet.VSPTicketInfo(ticketTx.WalletID, ticketTx.Hash)
 		if err != nil {
 			log.Errorf("VSPTicketInfo error: %v\n", err)
 		} else {
@@ -350,7 +352,7 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 		NegativeButton(values.String(values.StrCancel), func() {
 			pg.stake.SetChecked(false)
 		}).
-		PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+		PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 			if !pg.WL.MultiWallet.IsConnectedToDecredNetwork() {
 				pg.Toast.NotifyError(values.String(values.StrNotConnected))
 				pm.SetLoading(false)
@@ -358,18 +360,22 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 				return false
 			}
 
+			// The ticket buyer keeps using the passphrase while it runs, so
+			// it gets a copy that isn't zeroed when the modal is dismissed.
+			ticketBuyerPassphrase := append([]byte(nil), password...)
 			go func() {
-				err := pg.WL.SelectedWallet.Wallet.StartTicketBuyer([]byte(password))
+				err := pg.WL.Wallet.StartTicketBuyer(pg.WL.SelectedWallet.Wallet, ticketBuyerPassphrase)
 				if err != nil {
-					pg.Toast.NotifyError(err.Error())
-					pm.SetLoading(false)
+					wallet.ZeroBytes(ticketBuyerPassphrase)
+					pm.Failed(err)
 					return
 				}
 
+				pm.Accepted()
+				pm.Dismiss()
 				pg.stake.SetChecked(pg.WL.SelectedWallet.Wallet.IsAutoTicketsPurchaseActive())
 				pg.ParentWindow().Reload()
 			}()
-			pm.Dismiss()
 
 			return false
 		})
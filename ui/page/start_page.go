@@ -5,6 +5,7 @@ import (
 
 	"gioui.org/layout"
 	"gioui.org/text"
+	"gioui.org/widget"
 
 	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/app"
@@ -12,6 +13,7 @@ import (
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 const StartPageID = "start_page"
@@ -31,6 +33,18 @@ type startPage struct {
 	legacyLayout decredmaterial.Button
 
 	loading bool
+
+	profiles            []wallet.Profile
+	profileRows         []*profileRow
+	profileList         *widget.List
+	createProfileButton decredmaterial.Button
+}
+
+// profileRow holds the widgets of an app profile on the profile picker.
+type profileRow struct {
+	selectProfile *decredmaterial.Clickable
+	renameProfile *decredmaterial.Clickable
+	removeProfile decredmaterial.IconButton
 }
 
 func NewStartPage(l *load.Load) app.Page {
@@ -39,7 +53,11 @@ func NewStartPage(l *load.Load) app.Page {
 		GenericPageModal: app.NewGenericPageModal(StartPageID),
 		loading:          true,
 
-		addWalletButton: l.Theme.Button(values.String(values.StrAddWallet)),
+		addWalletButton:     l.Theme.Button(values.String(values.StrAddWallet)),
+		createProfileButton: l.Theme.OutlineButton(values.String(values.StrCreateProfile)),
+		profileList: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
 	}
 
 	return sp
@@ -50,36 +68,156 @@ func NewStartPage(l *load.Load) app.Page {
 // the page is displayed.
 // Part of the load.Page interface.
 func (sp *startPage) OnNavigatedTo() {
+	// The multiwallet is loaded after an app profile is chosen if there are
+	// profiles other than the default and none was set in the config.
+	if sp.isChoosingProfile() {
+		sp.loadProfiles()
+		sp.loading = false
+		return
+	}
+
+	sp.loading = true
 	sp.WL.MultiWallet = sp.WL.Wallet.GetMultiWallet()
 
 	if sp.WL.MultiWallet.LoadedWalletsCount() > 0 {
 		if sp.WL.MultiWallet.IsStartupSecuritySet() {
 			sp.unlock()
 		} else {
-			go sp.openWallets("")
+			go sp.openWallets(nil)
 		}
 	} else {
 		sp.loading = false
 	}
 }
 
+func (sp *startPage) isChoosingProfile() bool {
+	return sp.WL.Wallet.GetMultiWallet() == nil
+}
+
+func (sp *startPage) loadProfiles() {
+	profiles, err := sp.WL.Wallet.Profiles()
+	if err != nil {
+		log.Errorf("Error reading app profiles: %v", err)
+		sp.Toast.NotifyError(err.Error())
+	}
+	if len(profiles) == 0 {
+		profiles = []wallet.Profile{{Name: wallet.DefaultProfileName, Network: sp.WL.Wallet.Net, Dir: sp.WL.Wallet.Root}}
+	}
+
+	sp.profiles = profiles
+	sp.profileRows = make([]*profileRow, len(profiles))
+	for i := range profiles {
+		removeProfile := sp.Theme.IconButton(sp.Theme.Icons.ContentClear)
+		removeProfile.Size = values.MarginPadding20
+		removeProfile.Inset = layout.UniformInset(values.MarginPadding4)
+		sp.profileRows[i] = &profileRow{
+			selectProfile: sp.Theme.NewClickable(true),
+			renameProfile: sp.Theme.NewClickable(false),
+			removeProfile: removeProfile,
+		}
+	}
+}
+
+// selectProfile loads the multiwallet of profile and continues to open its
+// wallets.
+func (sp *startPage) selectProfile(profile wallet.Profile) {
+	sp.loading = true
+	go func() {
+		err := sp.WL.Wallet.SelectProfile(profile.Name)
+		if err == nil {
+			err = sp.WL.Wallet.InitMultiWallet()
+		}
+		if err != nil {
+			log.Errorf("Error loading profile %s: %v", profile.Name, err)
+			sp.Toast.NotifyError(err.Error())
+			sp.loading = false
+			sp.ParentWindow().Reload()
+			return
+		}
+
+		log.Infof("Loaded profile %s", profile.Name)
+		sp.ProfileSelected()
+		sp.OnNavigatedTo()
+		sp.ParentWindow().Reload()
+	}()
+}
+
+func (sp *startPage) showCreateProfileModal() {
+	createProfileModal := modal.NewCreateProfileModal(sp.Load).
+		ProfileCreated(func(name, network string, m *modal.CreateProfileModal) bool {
+			if _, err := sp.WL.Wallet.CreateProfile(name, network); err != nil {
+				m.SetError(profileErr(err))
+				m.SetLoading(false)
+				return false
+			}
+			sp.loadProfiles()
+			return true
+		})
+	sp.ParentWindow().ShowModal(createProfileModal)
+}
+
+func (sp *startPage) showRenameProfileModal(profile wallet.Profile) {
+	textModal := modal.NewTextInputModal(sp.Load).
+		Hint(values.String(values.StrProfileName)).
+		PositiveButtonStyle(sp.Theme.Color.Primary, sp.Theme.Color.InvText).
+		PositiveButton(values.String(values.StrRename), func(newName string, tim *modal.TextInputModal) bool {
+			if err := sp.WL.Wallet.RenameProfile(profile.Name, newName); err != nil {
+				tim.SetError(profileErr(err))
+				tim.SetLoading(false)
+				return false
+			}
+			sp.loadProfiles()
+			return true
+		})
+	textModal.Title(values.String(values.StrRenameProfile)).
+		NegativeButton(values.String(values.StrCancel), func() {})
+	sp.ParentWindow().ShowModal(textModal)
+}
+
+func (sp *startPage) showRemoveProfileModal(profile wallet.Profile) {
+	removeModal := modal.NewInfoModal(sp.Load).
+		Title(values.String(values.StrRemoveProfile)).
+		Body(values.StringF(values.StrRemoveProfileInfo, profile.Name, profile.Dir)).
+		NegativeButton(values.String(values.StrCancel), func() {}).
+		PositiveButtonStyle(sp.Theme.Color.Surface, sp.Theme.Color.Danger).
+		PositiveButton(values.String(values.StrRemove), func(isChecked bool) bool {
+			if err := sp.WL.Wallet.DeleteProfile(profile.Name); err != nil {
+				sp.Toast.NotifyError(profileErr(err))
+				return true
+			}
+			sp.loadProfiles()
+			return true
+		})
+	sp.ParentWindow().ShowModal(removeModal)
+}
+
+// profileErr returns the message to display for an error from a profile
+// operation.
+func profileErr(err error) string {
+	if err == wallet.ErrProfileExists {
+		return values.String(values.StrProfileExists)
+	}
+	return err.Error()
+}
+
 func (sp *startPage) unlock() {
 	startupPasswordModal := modal.NewPasswordModal(sp.Load).
 		Title(values.String(values.StrUnlockWithPassword)).
 		Hint(values.String(values.StrStartupPassword)).
+		PassphraseScope(wallet.PassphraseScopeStartup).
 		NegativeButton(values.String(values.StrExit), func() {
 			sp.WL.MultiWallet.Shutdown()
 			os.Exit(0)
 		}).
-		PositiveButton(values.String(values.StrUnlock), func(password string, m *modal.PasswordModal) bool {
+		PositiveButton(values.String(values.StrUnlock), func(password []byte, m *modal.PasswordModal) bool {
 			go func() {
 				err := sp.openWallets(password)
 				if err != nil {
-					m.SetError(translateErr(err))
-					m.SetLoading(false)
+					m.Failed(err)
 					return
 				}
 
+				m.Accepted()
 				m.Dismiss()
 			}()
 			return false
@@ -87,8 +225,8 @@ func (sp *startPage) unlock() {
 	sp.ParentWindow().ShowModal(startupPasswordModal)
 }
 
-func (sp *startPage) openWallets(password string) error {
-	err := sp.WL.MultiWallet.OpenWallets([]byte(password))
+func (sp *startPage) openWallets(password []byte) error {
+	err := sp.WL.MultiWallet.OpenWallets(password)
 	if err != nil {
 		log.Info("Error opening wallet:", err)
 		// show err dialog
@@ -114,6 +252,27 @@ func (sp *startPage) HandleUserInteractions() {
 	for sp.addWalletButton.Clicked() {
 		sp.ParentNavigator().Display(NewCreateWallet(sp.Load))
 	}
+
+	if !sp.isChoosingProfile() || sp.loading {
+		return
+	}
+
+	for sp.createProfileButton.Clicked() {
+		sp.showCreateProfileModal()
+	}
+
+	for i, row := range sp.profileRows {
+		profile := sp.profiles[i]
+		for row.selectProfile.Clicked() {
+			sp.selectProfile(profile)
+		}
+		for row.renameProfile.Clicked() {
+			sp.showRenameProfileModal(profile)
+		}
+		for row.removeProfile.Button.Clicked() {
+			sp.showRemoveProfileModal(profile)
+		}
+	}
 }
 
 // OnNavigatedFrom is called when the page is about to be removed from
@@ -154,7 +313,12 @@ func (sp *startPage) layoutDesktop(gtx C) D {
 			return layout.Inset{
 				Left:  values.MarginPadding24,
 				Right: values.MarginPadding24,
-			}.Layout(gtx, sp.addWalletButton.Layout)
+			}.Layout(gtx, func(gtx C) D {
+				if sp.isChoosingProfile() {
+					return sp.profilePickerLayout(gtx)
+				}
+				return sp.addWalletButton.Layout(gtx)
+			})
 		}),
 	)
 }
@@ -176,6 +340,10 @@ func (sp *startPage) loadingSection(gtx C) D {
 					})
 				}),
 				layout.Rigid(func(gtx C) D {
+					if sp.isChoosingProfile() {
+						return D{}
+					}
+
 					netType := sp.WL.Wallet.Net
 					if sp.WL.Wallet.Net == dcrlibwallet.Testnet3 {
 						netType = "Testnet"
@@ -188,7 +356,7 @@ func (sp *startPage) loadingSection(gtx C) D {
 				layout.Rigid(func(gtx C) D {
 					if sp.loading {
 						loadStatus := sp.Theme.Label(values.TextSize20, values.String(values.StrLoading))
-						if sp.WL.MultiWallet.LoadedWalletsCount() > 0 {
+						if sp.WL.MultiWallet != nil && sp.WL.MultiWallet.LoadedWalletsCount() > 0 {
 							loadStatus.Text = values.String(values.StrOpeningWallet)
 						}
 
@@ -196,6 +364,9 @@ func (sp *startPage) loadingSection(gtx C) D {
 					}
 
 					welcomeText := sp.Theme.Label(values.TextSize24, values.String(values.StrWelcomeNote))
+					if sp.isChoosingProfile() {
+						welcomeText.Text = values.String(values.StrChooseProfile)
+					}
 					welcomeText.Alignment = text.Middle
 					return layout.Inset{Top: values.MarginPadding24}.Layout(gtx, welcomeText.Layout)
 				}),
@@ -223,7 +394,71 @@ func (sp *startPage) layoutMobile(gtx C) D {
 			return layout.Inset{
 				Left:  values.MarginPadding24,
 				Right: values.MarginPadding24,
-			}.Layout(gtx, sp.addWalletButton.Layout)
+			}.Layout(gtx, func(gtx C) D {
+				if sp.isChoosingProfile() {
+					return sp.profilePickerLayout(gtx)
+				}
+				return sp.addWalletButton.Layout(gtx)
+			})
+		}),
+	)
+}
+
+// profilePickerLayout lists the app profiles to choose from.
+func (sp *startPage) profilePickerLayout(gtx C) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return sp.Theme.Card().Layout(gtx, func(gtx C) D {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return sp.Theme.List(sp.profileList).Layout(gtx, len(sp.profiles), func(gtx C, i int) D {
+					return sp.profileRowLayout(gtx, sp.profiles[i], sp.profileRows[i])
+				})
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, sp.createProfileButton.Layout)
+		}),
+	)
+}
+
+func (sp *startPage) profileRowLayout(gtx C, profile wallet.Profile, row *profileRow) D {
+	name := profile.Name
+	if profile.IsDefault() {
+		name = values.String(values.StrDefaultProfile)
+	}
+
+	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		layout.Flexed(1, func(gtx C) D {
+			return row.selectProfile.Layout(gtx, func(gtx C) D {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return layout.UniformInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+						layout.Rigid(sp.Theme.Body1(name).Layout),
+						layout.Rigid(func(gtx C) D {
+							network := sp.Theme.Body2(modal.NetworkName(profile.Network))
+							network.Color = sp.Theme.Color.GrayText2
+							return network.Layout(gtx)
+						}),
+					)
+				})
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			if profile.IsDefault() {
+				return D{}
+			}
+			return row.renameProfile.Layout(gtx, func(gtx C) D {
+				return layout.UniformInset(values.MarginPadding8).Layout(gtx, func(gtx C) D {
+					return sp.Theme.Icons.EditIcon.Layout16dp(gtx)
+				})
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			if profile.IsDefault() {
+				return D{}
+			}
+			return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, row.removeProfile.Layout)
 		}),
 	)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/planetdecred/godcr/ui/load"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 const StatisticsPageID = "Statistics"
@@ -95,32 +96,12 @@ func (pg *StatPage) layoutStats(gtx C) D {
 		}
 	}
 
-	bestBlock := pg.WL.MultiWallet.GetBestBlock()
-	bestBlockTime := time.Unix(bestBlock.Timestamp, 0)
-	secondsSinceBestBlock := int64(time.Since(bestBlockTime).Seconds())
-
-	items := []layout.Widget{
-		item(values.String(values.StrBuild), pg.netType+", "+time.Now().Format("2006-01-02")),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrPeersConnected), strconv.Itoa(int(pg.WL.MultiWallet.ConnectedPeers()))),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrUptime), pg.startupTime),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrNetwork), pg.netType),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrBestBlocks), fmt.Sprintf("%d", bestBlock.Height)),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrBestBlockTimestamp), bestBlockTime.Format("2006-01-02 03:04:05 -0700")),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrBestBlockAge), components.SecondsToDays(secondsSinceBestBlock)),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrWalletDirectory), pg.WL.WalletDirectory()),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrDateSize), pg.WL.DataSize()),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrTransactions), fmt.Sprintf("%d", len(pg.txs))),
-		pg.Theme.Separator().Layout,
-		item(values.String(values.StrWallets), fmt.Sprintf("%d", pg.WL.MultiWallet.LoadedWalletsCount())),
+	var items []layout.Widget
+	for i, stat := range pg.stats() {
+		if i > 0 {
+			items = append(items, pg.Theme.Separator().Layout)
+		}
+		items = append(items, item(stat.Name, stat.Value))
 	}
 
 	return pg.Theme.List(pg.scrollbarList).Layout(gtx, 1, func(gtx C, i int) D {
@@ -136,6 +117,28 @@ func (pg *StatPage) layoutStats(gtx C) D {
 	})
 }
 
+// stats returns the values shown on the page. They are also added to
+// diagnostics bundles.
+func (pg *StatPage) stats() []wallet.DiagnosticsValue {
+	bestBlock := pg.WL.MultiWallet.GetBestBlock()
+	bestBlockTime := time.Unix(bestBlock.Timestamp, 0)
+	secondsSinceBestBlock := int64(time.Since(bestBlockTime).Seconds())
+
+	return []wallet.DiagnosticsValue{
+		{Name: values.String(values.StrBuild), Value: pg.netType + ", " + time.Now().Format("2006-01-02")},
+		{Name: values.String(values.StrPeersConnected), Value: strconv.Itoa(int(pg.WL.MultiWallet.ConnectedPeers()))},
+		{Name: values.String(values.StrUptime), Value: pg.startupTime},
+		{Name: values.String(values.StrNetwork), Value: pg.netType},
+		{Name: values.String(values.StrBestBlocks), Value: fmt.Sprintf("%d", bestBlock.Height)},
+		{Name: values.String(values.StrBestBlockTimestamp), Value: bestBlockTime.Format("2006-01-02 03:04:05 -0700")},
+		{Name: values.String(values.StrBestBlockAge), Value: components.SecondsToDays(secondsSinceBestBlock)},
+		{Name: values.String(values.StrWalletDirectory), Value: pg.WL.WalletDirectory(), Private: true},
+		{Name: values.String(values.StrDateSize), Value: pg.WL.DataSize()},
+		{Name: values.String(values.StrTransactions), Value: fmt.Sprintf("%d", len(pg.txs))},
+		{Name: values.String(values.StrWallets), Value: fmt.Sprintf("%d", pg.WL.MultiWallet.LoadedWalletsCount())},
+	}
+}
+
 // Layout draws the page UI components into the provided C
 // to be eventually drawn on screen.
 // Part of the load.Page interface.
@@ -0,0 +1,204 @@
+package page
+
+import (
+	"fmt"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// taskCentre is the top bar button and popover that list the background
+// tasks of the app.
+type taskCentre struct {
+	*load.Load
+
+	toggle     decredmaterial.IconButton
+	open       bool
+	clearEnded decredmaterial.Button
+	list       *widget.List
+
+	// cancelButtons are the cancel buttons of the listed tasks by task ID.
+	cancelButtons map[int]decredmaterial.Button
+}
+
+func newTaskCentre(l *load.Load) *taskCentre {
+	tc := &taskCentre{
+		Load:          l,
+		toggle:        l.Theme.IconButton(l.Theme.Icons.Cached),
+		clearEnded:    l.Theme.OutlineButton(values.String(values.StrClearFinished)),
+		list:          &widget.List{List: layout.List{Axis: layout.Vertical}},
+		cancelButtons: make(map[int]decredmaterial.Button),
+	}
+	tc.toggle.Size = unit.Dp(19)
+	tc.toggle.Inset = layout.UniformInset(values.MarginPadding4)
+	tc.clearEnded.TextSize = values.TextSize12
+	return tc
+}
+
+func (tc *taskCentre) handle() {
+	for tc.toggle.Button.Clicked() {
+		tc.open = !tc.open
+	}
+
+	for tc.clearEnded.Clicked() {
+		tc.WL.Wallet.ClearEndedTasks()
+	}
+
+	for id, btn := range tc.cancelButtons {
+		for btn.Clicked() {
+			go func(id int) {
+				if err := tc.WL.Wallet.CancelTask(id); err != nil {
+					tc.Toast.NotifyError(values.String(values.StrTaskCancelFailed))
+				}
+			}(id)
+		}
+	}
+}
+
+// layoutButton lays out the top bar button with the number of running tasks.
+func (tc *taskCentre) layoutButton(gtx C) D {
+	running := tc.WL.Wallet.RunningTasks()
+	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(tc.toggle.Layout),
+		layout.Rigid(func(gtx C) D {
+			if running == 0 {
+				return D{}
+			}
+			label := tc.Theme.Label(values.TextSize12, fmt.Sprint(running))
+			label.Color = tc.Theme.Color.Surface
+			return tc.Theme.Badge().Layout(gtx, label)
+		}),
+	)
+}
+
+// layoutPanel lays out the list of tasks below the top bar when it is open.
+func (tc *taskCentre) layoutPanel(gtx C) D {
+	if !tc.open {
+		return D{}
+	}
+
+	tasks := tc.WL.Wallet.Tasks()
+	buttons := make(map[int]decredmaterial.Button, len(tasks))
+	hasEnded := false
+	for _, task := range tasks {
+		if task.Cancelable {
+			btn, ok := tc.cancelButtons[task.ID]
+			if !ok {
+				btn = tc.Theme.OutlineButton(values.String(values.StrCancel))
+				btn.TextSize = values.TextSize12
+			}
+			buttons[task.ID] = btn
+		}
+		if task.State != wallet.TaskRunning {
+			hasEnded = true
+		}
+	}
+	tc.cancelButtons = buttons
+
+	// Progress is not notified, so redraw while the panel is open.
+	op.InvalidateOp{At: time.Now().Add(time.Second)}.Add(gtx.Ops)
+
+	return layout.Inset{Top: values.MarginPadding60, Right: values.MarginPadding24}.Layout(gtx, func(gtx C) D {
+		gtx.Constraints.Min.X = gtx.Dp(values.MarginPadding350)
+		gtx.Constraints.Max.X = gtx.Constraints.Min.X
+		gtx.Constraints.Max.Y = gtx.Dp(values.MarginPadding450)
+		card := tc.Theme.Card()
+		card.Radius = decredmaterial.Radius(8)
+		return card.Layout(gtx, func(gtx C) D {
+			return layout.UniformInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						title := tc.Theme.Label(values.TextSize16, values.String(values.StrBackgroundTasks))
+						title.Font.Weight = text.SemiBold
+						if !hasEnded {
+							return title.Layout(gtx)
+						}
+						return components.EndToEndRow(gtx, title.Layout, tc.clearEnded.Layout)
+					}),
+					layout.Rigid(func(gtx C) D {
+						if len(tasks) > 0 {
+							return D{}
+						}
+						empty := tc.Theme.Body2(values.String(values.StrNoBackgroundTasks))
+						empty.Color = tc.Theme.Color.GrayText2
+						return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, empty.Layout)
+					}),
+					layout.Flexed(1, func(gtx C) D {
+						return tc.Theme.List(tc.list).Layout(gtx, len(tasks), func(gtx C, i int) D {
+							return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+								return tc.taskRow(gtx, tasks[i])
+							})
+						})
+					}),
+				)
+			})
+		})
+	})
+}
+
+func (tc *taskCentre) taskRow(gtx C, task wallet.TaskInfo) D {
+	name := components.TaskName(task.Kind)
+	if w := tc.WL.MultiWallet.WalletWithID(task.WalletID); w != nil {
+		name += " · " + w.Name
+	}
+
+	status := tc.Theme.Caption(values.String(values.StrTaskRunning))
+	status.Color = tc.Theme.Color.GrayText2
+	switch task.State {
+	case wallet.TaskRunning:
+		if task.Progress >= 0 {
+			status.Text = fmt.Sprintf("%d%%", task.Progress)
+		}
+	case wallet.TaskDone:
+		status.Text = values.String(values.StrTaskDone)
+		status.Color = tc.Theme.Color.Success
+	case wallet.TaskCanceled:
+		status.Text = values.String(values.StrTaskCanceled)
+	case wallet.TaskFailed:
+		status.Text = values.String(values.StrTaskFailed)
+		status.Color = tc.Theme.Color.Danger
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, tc.Theme.Body2(name).Layout, status.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			if task.State != wallet.TaskRunning || task.Progress < 0 {
+				return D{}
+			}
+			p := tc.Theme.ProgressBar(task.Progress)
+			p.Height = values.MarginPadding4
+			p.Color = tc.Theme.Color.Success
+			p.TrackColor = tc.Theme.Color.Gray2
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, p.Layout2)
+		}),
+		layout.Rigid(func(gtx C) D {
+			if task.Error == "" {
+				return D{}
+			}
+			errLabel := tc.Theme.Caption(task.Error)
+			errLabel.Color = tc.Theme.Color.Danger
+			return errLabel.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			started := tc.Theme.Caption(components.TimeAgo(task.Started.Unix()))
+			started.Color = tc.Theme.Color.GrayText3
+			btn, ok := tc.cancelButtons[task.ID]
+			if !ok {
+				return started.Layout(gtx)
+			}
+			return components.EndToEndRow(gtx, started.Layout, btn.Layout)
+		}),
+	)
+}
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"time"
 
-	"gioui.org/io/clipboard"
 	"gioui.org/layout"
 	"gioui.org/widget"
 
@@ -17,6 +16,7 @@ import (
 	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 const TransactionDetailsPageID = "TransactionDetails"
@@ -28,6 +28,7 @@ type transactionWdg struct {
 	time, status, wallet decredmaterial.Label
 
 	copyTextButtons []decredmaterial.Button
+	explorerButtons []*decredmaterial.Clickable
 }
 
 type TxDetailsPage struct {
@@ -46,6 +47,7 @@ type TxDetailsPage struct {
 	associatedTicketClickable       *decredmaterial.Clickable
 	hashClickable                   *widget.Clickable
 	destAddressClickable            *widget.Clickable
+	blockClickable                  *widget.Clickable
 	dot                             *decredmaterial.Icon
 	toDcrdata                       *decredmaterial.Clickable
 	outputsCollapsible              *decredmaterial.Collapsible
@@ -55,7 +57,6 @@ type TxDetailsPage struct {
 	rebroadcast                     decredmaterial.Label
 	rebroadcastClickable            *decredmaterial.Clickable
 	rebroadcastIcon                 *decredmaterial.Image
-	copyRedirectURL                 *decredmaterial.Clickable
 
 	txnWidgets    transactionWdg
 	transaction   *dcrlibwallet.Transaction
@@ -63,6 +64,7 @@ type TxDetailsPage struct {
 	ticketSpent   *dcrlibwallet.Transaction // ticket spent in a vote or revoke
 	txBackStack   *dcrlibwallet.Transaction // track original transaction
 	wallet        *dcrlibwallet.Wallet
+	explorer      wallet.BlockExplorer
 
 	txSourceAccount      string
 	txDestinationAddress string
@@ -94,8 +96,8 @@ func NewTransactionDetailsPage(l *load.Load, transaction *dcrlibwallet.Transacti
 		associatedTicketClickable: l.Theme.NewClickable(true),
 		hashClickable:             new(widget.Clickable),
 		destAddressClickable:      new(widget.Clickable),
+		blockClickable:            new(widget.Clickable),
 		toDcrdata:                 l.Theme.NewClickable(true),
-		copyRedirectURL:           l.Theme.NewClickable(false),
 
 		transaction:          transaction,
 		wallet:               l.WL.MultiWallet.WalletWithID(transaction.WalletID),
@@ -149,6 +151,7 @@ func (pg *TxDetailsPage) OnNavigatedTo() {
 		pg.ticketSpender, _ = pg.wallet.TicketSpender(pg.transaction.Hash)
 	}
 
+	pg.explorer = pg.WL.Wallet.BlockExplorer()
 	pg.getTXSourceAccountAndDirection()
 	pg.txnWidgets = initTxnWidgets(pg.Load, pg.transaction)
 }
@@ -463,7 +466,7 @@ func (pg *TxDetailsPage) ticketDetails(gtx C) D {
 					if pg.transaction.Type == dcrlibwallet.TxTypeVote {
 						return layout.Inset{Top: values.MarginPadding12}.Layout(gtx, func(gtx C) D {
 							txt := values.String(values.StrReward)
-							return pg.txnInfoSection(gtx, txt, dcrutil.Amount(pg.transaction.VoteReward).String(), false, nil)
+							return pg.txnInfoSection(gtx, txt, pg.MaskAmount(dcrutil.Amount(pg.transaction.VoteReward).String()), false, nil)
 						})
 					}
 					return D{}
@@ -535,13 +538,17 @@ func (pg *TxDetailsPage) txnTypeAndID(gtx layout.Context) layout.Dimensions {
 		}),
 		layout.Rigid(func(gtx C) D {
 			return layout.Inset{Top: m}.Layout(gtx, func(gtx C) D {
-				return pg.txnInfoSection(gtx, values.String(values.StrFee), dcrutil.Amount(transaction.Fee).String(), false, nil)
+				return pg.txnInfoSection(gtx, values.String(values.StrFee), pg.MaskAmount(dcrutil.Amount(transaction.Fee).String()), false, nil)
 			})
 		}),
 		layout.Rigid(func(gtx C) D {
 			if transaction.BlockHeight != -1 {
+				var clickable *widget.Clickable
+				if pg.explorer.BlockLink(transaction.BlockHeight) != "" {
+					clickable = pg.blockClickable
+				}
 				return layout.Inset{Top: m}.Layout(gtx, func(gtx C) D {
-					return pg.txnInfoSection(gtx, values.String(values.StrIncludedInBlock), fmt.Sprintf("%d", transaction.BlockHeight), false, nil)
+					return pg.txnInfoSection(gtx, values.String(values.StrIncludedInBlock), fmt.Sprintf("%d", transaction.BlockHeight), false, clickable)
 				})
 			}
 			return layout.Dimensions{}
@@ -628,7 +635,8 @@ func (pg *TxDetailsPage) txnInputs(gtx layout.Context) layout.Dimensions {
 	collapsibleBody := func(gtx C) D {
 		return pg.transactionInputsContainer.Layout(gtx, len(transaction.Inputs), func(gtx C, i int) D {
 			input := transaction.Inputs[i]
-			return pg.txnIORow(gtx, input.Amount, input.AccountNumber, input.PreviousOutpoint, i)
+			prevTxHash := strings.Split(input.PreviousOutpoint, ":")[0]
+			return pg.txnIORow(gtx, input.Amount, input.AccountNumber, input.PreviousOutpoint, pg.explorer.TxLink(prevTxHash), i)
 		})
 	}
 	return pg.pageSections(gtx, func(gtx C) D {
@@ -649,7 +657,7 @@ func (pg *TxDetailsPage) txnOutputs(gtx layout.Context) layout.Dimensions {
 		x := len(transaction.Inputs)
 		return pg.transactionOutputsContainer.Layout(gtx, len(transaction.Outputs), func(gtx C, i int) D {
 			output := transaction.Outputs[i]
-			return pg.txnIORow(gtx, output.Amount, output.AccountNumber, output.Address, i+x)
+			return pg.txnIORow(gtx, output.Amount, output.AccountNumber, output.Address, pg.explorer.AddressLink(output.Address), i+x)
 		})
 	}
 	return pg.pageSections(gtx, func(gtx C) D {
@@ -657,7 +665,7 @@ func (pg *TxDetailsPage) txnOutputs(gtx layout.Context) layout.Dimensions {
 	})
 }
 
-func (pg *TxDetailsPage) txnIORow(gtx layout.Context, amount int64, acctNum int32, address string, i int) layout.Dimensions {
+func (pg *TxDetailsPage) txnIORow(gtx layout.Context, amount int64, acctNum int32, address, explorerURL string, i int) layout.Dimensions {
 
 	accountName := values.String(values.StrExternal)
 	walletName := ""
@@ -681,7 +689,7 @@ func (pg *TxDetailsPage) txnIORow(gtx layout.Context, amount int64, acctNum int3
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
 						return layout.Flex{}.Layout(gtx,
-							layout.Rigid(pg.Theme.Body1(amt).Layout),
+							layout.Rigid(pg.Theme.Body1(pg.MaskAmount(amt)).Layout),
 							layout.Rigid(func(gtx C) D {
 								m := values.MarginPadding5
 								return layout.Inset{
@@ -706,7 +714,20 @@ func (pg *TxDetailsPage) txnIORow(gtx layout.Context, amount int64, acctNum int3
 					layout.Rigid(func(gtx C) D {
 						pg.txnWidgets.copyTextButtons[i].Text = address
 
-						return layout.W.Layout(gtx, pg.txnWidgets.copyTextButtons[i].Layout)
+						return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+							layout.Rigid(pg.txnWidgets.copyTextButtons[i].Layout),
+							layout.Rigid(func(gtx C) D {
+								if explorerURL == "" {
+									return D{}
+								}
+								if pg.txnWidgets.explorerButtons[i].Clicked() {
+									pg.showExplorerURL(explorerURL)
+								}
+								return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+									return pg.txnWidgets.explorerButtons[i].Layout(gtx, pg.Theme.Icons.RedirectIcon.Layout16dp)
+								})
+							}),
+						)
 					}),
 				)
 			})
@@ -715,11 +736,15 @@ func (pg *TxDetailsPage) txnIORow(gtx layout.Context, amount int64, acctNum int3
 }
 
 func (pg *TxDetailsPage) viewTxn(gtx layout.Context) layout.Dimensions {
+	if pg.explorer.TxLink(pg.transaction.Hash) == "" {
+		return D{}
+	}
+
 	return pg.pageSections(gtx, func(gtx C) D {
 		return pg.toDcrdata.Layout(gtx, func(gtx C) D {
 			gtx.Constraints.Min.X = gtx.Constraints.Max.X
 			return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
-				layout.Rigid(pg.Theme.Body1(values.String(values.StrViewOnDcrdata)).Layout),
+				layout.Rigid(pg.Theme.Body1(values.StringF(values.StrViewOnExplorer, modal.BlockExplorerName(pg.explorer))).Layout),
 				layout.Rigid(pg.Theme.Icons.RedirectIcon.Layout24dp),
 			)
 		})
@@ -737,54 +762,11 @@ func (pg *TxDetailsPage) pageSections(gtx layout.Context, body layout.Widget) la
 // Part of the load.Page interface.
 func (pg *TxDetailsPage) HandleUserInteractions() {
 	for pg.toDcrdata.Clicked() {
-		redirectURL := pg.WL.Wallet.GetBlockExplorerURL(pg.transaction.Hash)
-		info := modal.NewInfoModal(pg.Load).
-			Title("View on Dcrdata").
-			Body("Copy and paste the link below in your browser, to view transaction on dcrdata dashboard.").
-			SetCancelable(true).
-			UseCustomWidget(func(gtx C) D {
-				return layout.Stack{}.Layout(gtx,
-					layout.Stacked(func(gtx C) D {
-						border := widget.Border{Color: pg.Theme.Color.Gray4, CornerRadius: values.MarginPadding10, Width: values.MarginPadding2}
-						wrapper := pg.Theme.Card()
-						wrapper.Color = pg.Theme.Color.Gray4
-						return border.Layout(gtx, func(gtx C) D {
-							return wrapper.Layout(gtx, func(gtx C) D {
-								return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-									return layout.Flex{}.Layout(gtx,
-										layout.Flexed(0.9, pg.Theme.Body1(redirectURL).Layout),
-										layout.Flexed(0.1, func(gtx C) D {
-											return layout.E.Layout(gtx, func(gtx C) D {
-												return layout.Inset{Top: values.MarginPadding7}.Layout(gtx, func(gtx C) D {
-													if pg.copyRedirectURL.Clicked() {
-														clipboard.WriteOp{Text: redirectURL}.Add(gtx.Ops)
-														pg.Toast.Notify("URL copied")
-													}
-													return pg.copyRedirectURL.Layout(gtx, pg.Theme.Icons.CopyIcon.Layout24dp)
-												})
-											})
-										}),
-									)
-								})
-							})
-						})
-					}),
-					layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-						return layout.Inset{
-							Top:  values.MarginPaddingMinus10,
-							Left: values.MarginPadding10,
-						}.Layout(gtx, func(gtx C) D {
-							label := pg.Theme.Body2("Web URL")
-							label.Color = pg.Theme.Color.GrayText2
-							return label.Layout(gtx)
-						})
-					}),
-				)
-			}).
-			PositiveButton("Got it", func(isChecked bool) bool {
-				return true
-			})
-		pg.ParentWindow().ShowModal(info)
+		pg.showExplorerURL(pg.explorer.TxLink(pg.transaction.Hash))
+	}
+
+	for pg.blockClickable.Clicked() {
+		pg.showExplorerURL(pg.explorer.BlockLink(pg.transaction.BlockHeight))
 	}
 
 	for pg.associatedTicketClickable.Clicked() {
@@ -823,21 +805,33 @@ func (pg *TxDetailsPage) HandleUserInteractions() {
 	}
 }
 
+// showExplorerURL shows explorerURL in a modal so that it can be copied and
+// opened in a browser.
+func (pg *TxDetailsPage) showExplorerURL(explorerURL string) {
+	pg.ParentWindow().ShowModal(modal.NewExplorerLinkModal(pg.Load, pg.explorer, explorerURL))
+}
+
 func (pg *TxDetailsPage) handleTextCopyEvent(gtx layout.Context) {
-	for _, b := range pg.txnWidgets.copyTextButtons {
+	// The inputs show previous outpoints and the outputs show addresses.
+	inputs := len(pg.transaction.Inputs)
+	for i, b := range pg.txnWidgets.copyTextButtons {
 		for b.Clicked() {
-			clipboard.WriteOp{Text: b.Text}.Add(gtx.Ops)
+			if i < inputs {
+				pg.CopySensitiveText(gtx, b.Text)
+			} else {
+				pg.CopyAddress(gtx, b.Text)
+			}
 			pg.Toast.Notify(values.String(values.StrCopied))
 		}
 	}
 
 	for pg.hashClickable.Clicked() {
-		clipboard.WriteOp{Text: pg.transaction.Hash}.Add(gtx.Ops)
+		pg.CopySensitiveText(gtx, pg.transaction.Hash)
 		pg.Toast.Notify(values.String(values.StrTxHashCopied))
 	}
 
 	for pg.destAddressClickable.Clicked() {
-		clipboard.WriteOp{Text: pg.txDestinationAddress}.Add(gtx.Ops)
+		pg.CopyAddress(gtx, pg.txDestinationAddress)
 		pg.Toast.Notify(values.String(values.StrAddressCopied))
 	}
 }
@@ -887,6 +881,10 @@ func initTxnWidgets(l *load.Load, transaction *dcrlibwallet.Transaction) transac
 		btn.Inset = layout.UniformInset(values.MarginPadding0)
 		txn.copyTextButtons[i] = btn
 	}
+	txn.explorerButtons = make([]*decredmaterial.Clickable, x)
+	for i := range txn.explorerButtons {
+		txn.explorerButtons[i] = l.Theme.NewClickable(false)
+	}
 
 	return txn
 }
@@ -34,7 +34,6 @@ type TransactionsPage struct {
 	// and the root WindowNavigator.
 	*app.GenericPageModal
 
-	*listeners.TxAndBlockNotificationListener
 	ctx       context.Context // page context
 	ctxCancel context.CancelFunc
 	separator decredmaterial.Line
@@ -388,34 +387,19 @@ func (pg *TransactionsPage) HandleUserInteractions() {
 }
 
 func (pg *TransactionsPage) listenForTxNotifications() {
-	if pg.TxAndBlockNotificationListener != nil {
-		return
-	}
-	pg.TxAndBlockNotificationListener = listeners.NewTxAndBlockNotificationListener()
-	err := pg.WL.MultiWallet.AddTxAndBlockNotificationListener(pg.TxAndBlockNotificationListener, true, TransactionsPageID)
-	if err != nil {
-		log.Errorf("Error adding tx and block notification listener: %v", err)
-		return
-	}
+	sub := pg.Bus.Subscribe(pg.ctx, listeners.DropOldest, listeners.TxAndBlockTopic)
 
 	go func() {
-		for {
-			select {
-			case n := <-pg.TxAndBlockNotifChan:
-				if n.Type == listeners.NewTransaction {
-
-					selectedWallet := pg.wallets[pg.walletDropDown.SelectedIndex()]
-					if selectedWallet.ID == n.Transaction.WalletID {
-						pg.loadTransactions(pg.walletDropDown.SelectedIndex())
-						pg.ParentWindow().Reload()
-					}
+		defer pg.RecoverPanic()
+
+		for event := range sub.Events() {
+			n, ok := event.(listeners.TxNotification)
+			if ok && n.Type == listeners.NewTransaction {
+				selectedWallet := pg.wallets[pg.walletDropDown.SelectedIndex()]
+				if selectedWallet.ID == n.Transaction.WalletID {
+					pg.loadTransactions(pg.walletDropDown.SelectedIndex())
+					pg.ParentWindow().Reload()
 				}
-			case <-pg.ctx.Done():
-				pg.WL.MultiWallet.RemoveTxAndBlockNotificationListener(TransactionsPageID)
-				close(pg.TxAndBlockNotifChan)
-				pg.TxAndBlockNotificationListener = nil
-
-				return
 			}
 		}
 	}()
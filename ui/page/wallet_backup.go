@@ -0,0 +1,139 @@
+package page
+
+import (
+	"strings"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/modal"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// walletBackupExtraConfigKeys are the godcr per-wallet settings saved in a
+// wallet backup alongside the dcrlibwallet settings.
+var walletBackupExtraConfigKeys = []string{
+	load.SpendUnmixedFundsKey,
+}
+
+// showCreateWalletBackupModal asks for a backup password and writes an
+// encrypted backup of the wallet's private settings to the app data directory.
+func showCreateWalletBackupModal(l *load.Load, window app.WindowNavigator, wal *dcrlibwallet.Wallet) {
+	backupModal := modal.NewCreatePasswordModal(l).
+		Title(values.String(values.StrCreateWalletBackup)).
+		SetDescription(values.String(values.StrWalletBackupInfo)).
+		EnableName(false).
+		UserInputs(wal.Name).
+		PasswordHint(values.String(values.StrBackupPassword)).
+		ConfirmPasswordHint(values.String(values.StrConfirmBackupPassword)).
+		PasswordCreated(func(_ string, password []byte, m *modal.CreatePasswordModal) bool {
+			go func() {
+				backup, err := l.WL.Wallet.ExportWalletBackup(wal, walletBackupExtraConfigKeys...)
+				if err != nil {
+					m.SetError(err.Error())
+					m.SetLoading(false)
+					return
+				}
+
+				path, err := l.WL.Wallet.SaveWalletBackup(backup, password)
+				if err != nil {
+					m.SetError(err.Error())
+					m.SetLoading(false)
+					return
+				}
+				m.Dismiss()
+
+				info := modal.NewInfoModal(l).
+					Title(values.String(values.StrWalletBackupSaved)).
+					Body(values.StringF(values.StrWalletBackupSavedTo, path)).
+					PositiveButton(values.String(values.StrGotIt), func(isChecked bool) bool {
+						return true
+					})
+				window.ShowModal(info)
+			}()
+			return false
+		})
+	window.ShowModal(backupModal)
+}
+
+// showRestoreWalletBackupModal offers to apply an encrypted wallet backup to
+// a freshly restored wallet. done is called once the backup is applied or the
+// user skips the step.
+func showRestoreWalletBackupModal(l *load.Load, window app.WindowNavigator, wal *dcrlibwallet.Wallet, done func()) {
+	applyBackup := func(backup *wallet.WalletBackup, privPass []byte) error {
+		if err := l.WL.Wallet.ApplyWalletBackup(wal, backup, privPass); err != nil {
+			return err
+		}
+		l.Toast.Notify(values.String(values.StrWalletSettingsRestored))
+		done()
+		return nil
+	}
+
+	backupPasswordModal := func(path string) *modal.PasswordModal {
+		return modal.NewPasswordModal(l).
+			Title(values.String(values.StrRestoreWalletSettings)).
+			Hint(values.String(values.StrBackupPassword)).
+			PassphraseScope("").
+			NegativeButton(values.String(values.StrSkip), done).
+			PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
+				go func() {
+					backup, err := wallet.LoadWalletBackup(path, password)
+					if err != nil {
+						pm.Failed(err)
+						return
+					}
+
+					if backup.MissingAccounts(wal) == 0 {
+						if err := applyBackup(backup, nil); err != nil {
+							pm.Failed(err)
+							return
+						}
+						pm.Dismiss()
+						return
+					}
+
+					// Missing accounts can only be created with the
+					// spending password of the restored wallet.
+					pm.Dismiss()
+					spendingPasswordModal := modal.NewPasswordModal(l).
+						Title(values.String(values.StrRestoreWalletSettings)).
+						NegativeButton(values.String(values.StrSkip), done).
+						PositiveButton(values.String(values.StrConfirm), func(privPass []byte, spm *modal.PasswordModal) bool {
+							go func() {
+								if err := applyBackup(backup, privPass); err != nil {
+									spm.Failed(err)
+									return
+								}
+								spm.Accepted()
+								spm.Dismiss()
+							}()
+							return false
+						})
+					window.ShowModal(spendingPasswordModal)
+				}()
+				return false
+			})
+	}
+
+	pathModal := modal.NewTextInputModal(l).
+		Hint(values.String(values.StrBackupFilePath)).
+		PositiveButtonStyle(l.Theme.Color.Primary, l.Theme.Color.InvText).
+		PositiveButton(values.String(values.StrContinue), func(path string, tm *modal.TextInputModal) bool {
+			path = strings.TrimSpace(path)
+			window.ShowModal(backupPasswordModal(path))
+			return true
+		})
+	pathModal.Title(values.String(values.StrRestoreWalletSettings)).
+		NegativeButton(values.String(values.StrSkip), done)
+
+	info := modal.NewInfoModal(l).
+		Title(values.String(values.StrRestoreWalletSettings)).
+		Body(values.String(values.StrRestoreWalletSettingsInfo)).
+		NegativeButton(values.String(values.StrSkip), done).
+		PositiveButton(values.String(values.StrRestore), func(isChecked bool) bool {
+			window.ShowModal(pathModal)
+			return true
+		})
+	window.ShowModal(info)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/planetdecred/godcr/ui/modal"
 	"github.com/planetdecred/godcr/ui/page/components"
 	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
 )
 
 const WalletSettingsPageID = "WalletSettings"
@@ -28,7 +29,7 @@ type WalletSettingsPage struct {
 
 	changePass, rescan, deleteWallet                *decredmaterial.Clickable
 	changeAccount, mixedAccount, coordinationServer *decredmaterial.Clickable
-	changeWalletName, addAccount                    *decredmaterial.Clickable
+	changeWalletName, addAccount, backupWallet      *decredmaterial.Clickable
 
 	chevronRightIcon        *decredmaterial.Icon
 	backButton              decredmaterial.IconButton
@@ -49,6 +50,7 @@ func NewWalletSettingsPage(l *load.Load, wal *dcrlibwallet.Wallet) *WalletSettin
 		coordinationServer: l.Theme.NewClickable(false),
 		changeWalletName:   l.Theme.NewClickable(false),
 		addAccount:         l.Theme.NewClickable(false),
+		backupWallet:       l.Theme.NewClickable(false),
 
 		chevronRightIcon:        decredmaterial.NewIcon(l.Theme.Icons.ChevronRight),
 		allowUnspendUnmixedAcct: l.Theme.Switch(),
@@ -92,6 +94,7 @@ func (pg *WalletSettingsPage) Layout(gtx layout.Context) layout.Dimensions {
 					pg.renameWallet(),
 					pg.account(),
 					pg.stakeshuffle(),
+					pg.backup(),
 					pg.debug(),
 					pg.dangerZone(),
 				}
@@ -166,6 +169,13 @@ func (pg *WalletSettingsPage) account() layout.Widget {
 	}
 }
 
+func (pg *WalletSettingsPage) backup() layout.Widget {
+	return func(gtx C) D {
+		return pg.pageSections(gtx, values.String(values.StrBackup),
+			pg.bottomSectionLabel(pg.backupWallet, values.String(values.StrCreateWalletBackup)))
+	}
+}
+
 func (pg *WalletSettingsPage) debug() layout.Widget {
 	return func(gtx C) D {
 		return pg.pageSections(gtx, values.String(values.StrDebug),
@@ -302,32 +312,40 @@ func (pg *WalletSettingsPage) HandleUserInteractions() {
 			Title(values.String(values.StrChangeSpendingPass)).
 			Hint(values.String(values.StrCurrentSpendingPassword)).
 			NegativeButton(values.String(values.StrCancel), func() {}).
-			PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+			PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 				go func() {
-					err := pg.wallet.UnlockWallet([]byte(password))
+					err := pg.wallet.UnlockWallet(password)
 					if err != nil {
-						pm.SetError(err.Error())
-						pm.SetLoading(false)
+						pm.Failed(err)
 						return
 					}
 					pg.wallet.LockWallet()
+					pm.Accepted()
+					// password is zeroed when this modal is dismissed but is
+					// needed to change the password.
+					currentPassword := append([]byte(nil), password...)
 					pm.Dismiss()
 
 					// change password
 					newSpendingPasswordModal := modal.NewCreatePasswordModal(pg.Load).
 						Title(values.String(values.StrChangeSpendingPass)).
 						EnableName(false).
+						UserInputs(pg.wallet.Name).
 						PasswordHint(values.String(values.StrNewSpendingPassword)).
 						ConfirmPasswordHint(values.String(values.StrConfirmNewSpendingPassword)).
-						PasswordCreated(func(walletName, newPassword string, m *modal.CreatePasswordModal) bool {
+						NegativeButton(func() {
+							wallet.ZeroBytes(currentPassword)
+						}).
+						PasswordCreated(func(walletName string, newPassword []byte, m *modal.CreatePasswordModal) bool {
 							go func() {
-								err := pg.WL.MultiWallet.ChangePrivatePassphraseForWallet(pg.wallet.ID, []byte(password),
-									[]byte(newPassword), dcrlibwallet.PassphraseTypePass)
+								err := pg.WL.MultiWallet.ChangePrivatePassphraseForWallet(pg.wallet.ID, currentPassword,
+									newPassword, dcrlibwallet.PassphraseTypePass)
 								if err != nil {
 									m.SetError(err.Error())
 									m.SetLoading(false)
 									return
 								}
+								wallet.ZeroBytes(currentPassword)
 								pg.Toast.Notify(values.String(values.StrSpendingPasswordUpdated))
 								m.Dismiss()
 							}()
@@ -343,29 +361,13 @@ func (pg *WalletSettingsPage) HandleUserInteractions() {
 		break
 	}
 
-	for pg.rescan.Clicked() {
-		go func() {
-			info := modal.NewInfoModal(pg.Load).
-				Title(values.String(values.StrRescanBlockchain)).
-				Body(values.String(values.StrRescanInfo)).
-				NegativeButton(values.String(values.StrCancel), func() {}).
-				PositiveButton(values.String(values.StrRescan), func(isChecked bool) bool {
-					err := pg.WL.MultiWallet.RescanBlocks(pg.wallet.ID)
-					if err != nil {
-						if err.Error() == dcrlibwallet.ErrNotConnected {
-							pg.Toast.NotifyError(values.String(values.StrNotConnected))
-							return true
-						}
-						pg.Toast.NotifyError(err.Error())
-						return true
-					}
-					msg := values.String(values.StrRescanProgressNotification)
-					pg.Toast.Notify(msg)
-					return true
-				})
+	for pg.backupWallet.Clicked() {
+		showCreateWalletBackupModal(pg.Load, pg.ParentWindow(), pg.wallet)
+		break
+	}
 
-			pg.ParentWindow().ShowModal(info)
-		}()
+	for pg.rescan.Clicked() {
+		pg.ParentWindow().ShowModal(components.NewRescanModal(pg.Load, pg.wallet.ID))
 		break
 	}
 
@@ -416,15 +418,15 @@ func (pg *WalletSettingsPage) HandleUserInteractions() {
 				walletPasswordModal := modal.NewPasswordModal(pg.Load).
 					Title(values.String(values.StrConfirmToRemove)).
 					NegativeButton(values.String(values.StrCancel), func() {}).
-					PositiveButton(values.String(values.StrConfirm), func(password string, pm *modal.PasswordModal) bool {
+					PositiveButton(values.String(values.StrConfirm), func(password []byte, pm *modal.PasswordModal) bool {
 						go func() {
-							err := pg.WL.MultiWallet.DeleteWallet(pg.wallet.ID, []byte(password))
+							err := pg.WL.MultiWallet.DeleteWallet(pg.wallet.ID, password)
 							if err != nil {
-								pm.SetError(err.Error())
-								pm.SetLoading(false)
+								pm.Failed(err)
 								return
 							}
 
+							pm.Accepted()
 							walletDeleted()
 							pm.Dismiss() // calls RefreshWindow.
 						}()
@@ -419,16 +419,17 @@ func (pg *CreateWallet) HandleUserInteractions() {
 	if (pg.continueBtn.Clicked() || isSubmit) && pg.validInputs() {
 		spendingPasswordModal := modal.NewCreatePasswordModal(pg.Load).
 			Title(values.String(values.StrSpendingPassword)).
+			UserInputs(pg.walletName.Editor.Text()).
 			NegativeButton(func() {}).
-			PasswordCreated(func(_, password string, m *modal.CreatePasswordModal) bool {
+			PasswordCreated(func(_ string, password []byte, m *modal.CreatePasswordModal) bool {
 				go func() {
-					wal, err := pg.WL.MultiWallet.CreateNewWallet(pg.walletName.Editor.Text(), password, dcrlibwallet.PassphraseTypePass)
+					wal, err := pg.WL.MultiWallet.CreateNewWallet(pg.walletName.Editor.Text(), string(password), dcrlibwallet.PassphraseTypePass)
 					if err != nil {
 						m.SetError(err.Error())
 						m.SetLoading(false)
 						return
 					}
-					err = wal.CreateMixerAccounts("mixed", "unmixed", password)
+					err = wal.CreateMixerAccounts("mixed", "unmixed", string(password))
 					if err != nil {
 						m.SetError(err.Error())
 						m.SetLoading(false)
@@ -448,7 +449,16 @@ func (pg *CreateWallet) HandleUserInteractions() {
 	if pg.restoreBtn.Clicked() {
 		afterRestore := func() {
 			// todo setup mixer for restored accounts automatically
-			pg.handlerWalletDexServerSelectorCallBacks()
+			wallets := pg.WL.SortedWalletList()
+			if len(wallets) == 0 {
+				pg.handlerWalletDexServerSelectorCallBacks()
+				return
+			}
+
+			// Offer to apply an encrypted settings backup to the wallet that
+			// was just restored, the most recently added wallet.
+			restoredWallet := wallets[len(wallets)-1]
+			showRestoreWalletBackupModal(pg.Load, pg.ParentWindow(), restoredWallet, pg.handlerWalletDexServerSelectorCallBacks)
 		}
 		pg.ParentNavigator().Display(info.NewRestorePage(pg.Load, afterRestore))
 	}
@@ -0,0 +1,176 @@
+package ui
+
+import (
+	"time"
+
+	giouiApp "gioui.org/app"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+
+	"github.com/planetdecred/godcr/ui/assets"
+	"github.com/planetdecred/godcr/ui/decredmaterial"
+	"github.com/planetdecred/godcr/ui/load"
+	"github.com/planetdecred/godcr/ui/page/components"
+	"github.com/planetdecred/godcr/ui/values"
+	"github.com/planetdecred/godcr/wallet"
+)
+
+// maxShutdownWait is how long the app waits for critical tasks before
+// quitting anyway.
+const maxShutdownWait = 2 * time.Minute
+
+// FinishCriticalTasks is called after the app window is closed. If critical
+// tasks run, it shows a window that lists them and lets the user stop the
+// tasks or quit now, until the critical tasks end. gio does not let the
+// closing of the app window be canceled, so the confirmation is shown in a
+// window of its own.
+func FinishCriticalTasks(wal *wallet.Wallet) {
+	tasks := len(wal.CriticalTasks())
+	if tasks == 0 {
+		return
+	}
+
+	log.Infof("Waiting for %d background tasks before quitting", tasks)
+	newShutdownWindow(wal).run()
+}
+
+type shutdownWindow struct {
+	*giouiApp.Window
+	wal       *wallet.Wallet
+	theme     *decredmaterial.Theme
+	stopTasks decredmaterial.Button
+	quitNow   decredmaterial.Button
+
+	// stopping is true once the user confirmed that the tasks are stopped.
+	stopping bool
+}
+
+func newShutdownWindow(wal *wallet.Wallet) *shutdownWindow {
+	th := decredmaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+	if mw := wal.GetMultiWallet(); mw != nil {
+		th.SwitchDarkMode(mw.ReadBoolConfigValueForKey(load.DarkModeConfigKey, false), assets.DecredIcons)
+	}
+
+	sw := &shutdownWindow{
+		Window: giouiApp.NewWindow(
+			giouiApp.Size(values.AppWidth, unit.Dp(360)),
+			giouiApp.Title(values.String(values.StrFinishingTasks)),
+		),
+		wal:       wal,
+		theme:     th,
+		stopTasks: th.OutlineButton(values.String(values.StrStopTasksAndQuit)),
+		quitNow:   th.Button(values.String(values.StrQuitNow)),
+	}
+	sw.stopTasks.Margin.Right = values.MarginPadding8
+	return sw
+}
+
+// run shows the window until the critical tasks end, the user quits or
+// maxShutdownWait passes. A running ticket buyer keeps the window open until
+// the user stops the tasks or quits.
+func (sw *shutdownWindow) run() {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.Now().Add(maxShutdownWait)
+
+	var ops op.Ops
+	for {
+		select {
+		case e := <-sw.Events():
+			switch evt := e.(type) {
+			case system.DestroyEvent:
+				return
+
+			case system.FrameEvent:
+				gtx := layout.NewContext(&ops, evt)
+				if sw.stopTasks.Clicked() && !sw.stopping {
+					log.Info("Stopping the background tasks to quit")
+					sw.stopping = true
+					sw.stopTasks.SetEnabled(false)
+					sw.wal.CheckpointTasks()
+				}
+				if sw.quitNow.Clicked() {
+					log.Warn("Quitting before the background tasks ended")
+					sw.Perform(system.ActionClose)
+				}
+				sw.layout(gtx)
+				evt.Frame(gtx.Ops)
+			}
+
+		case <-ticker.C:
+			if len(sw.wal.CriticalTasks()) == 0 {
+				sw.Perform(system.ActionClose)
+			} else if time.Now().After(deadline) {
+				log.Warn("Background tasks did not end in time, quitting")
+				sw.Perform(system.ActionClose)
+			}
+			sw.Invalidate()
+		}
+	}
+}
+
+func (sw *shutdownWindow) layout(gtx C) D {
+	ticketBuyers := sw.wal.TicketBuyerTasks()
+	tasks := sw.wal.CriticalTasks()
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			title := sw.theme.H6(values.String(values.StrFinishingTasks))
+			title.Font.Weight = text.SemiBold
+			return title.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			info := sw.theme.Body2(values.String(values.StrFinishingTasksInfo))
+			info.Color = sw.theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding8}.Layout(gtx, info.Layout)
+		}),
+	}
+
+	for _, task := range tasks {
+		name := components.TaskName(task.Kind)
+		if mw := sw.wal.GetMultiWallet(); mw != nil {
+			if w := mw.WalletWithID(task.WalletID); w != nil {
+				name += " · " + w.Name
+			}
+		}
+		started := sw.theme.Caption(components.TimeAgo(task.Started.Unix()))
+		started.Color = sw.theme.Color.GrayText3
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, sw.theme.Body1(name).Layout, started.Layout)
+			})
+		}))
+	}
+
+	if len(ticketBuyers) > 0 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			info := sw.theme.Body2(values.String(values.StrTicketBuyerShutdownInfo))
+			info.Color = sw.theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, info.Layout)
+		}))
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{}.Layout(gtx,
+					layout.Rigid(sw.stopTasks.Layout),
+					layout.Rigid(sw.quitNow.Layout),
+				)
+			})
+		})
+	}))
+
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx C) D {
+			return decredmaterial.Fill(gtx, sw.theme.Color.Surface)
+		}),
+		layout.Stacked(func(gtx C) D {
+			return layout.UniformInset(values.MarginPadding24).Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+			})
+		}),
+	)
+}
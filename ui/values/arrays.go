@@ -5,11 +5,27 @@ import "github.com/planetdecred/godcr/ui/values/localizable"
 var (
 	ArrLanguages          map[string]string
 	ArrExchangeCurrencies map[string]string
+	ArrAutoLockTimeouts   map[string]string
+	ArrClipboardDelays    map[string]string
+	ArrMinPasswordScores  map[string]string
+	ArrSyncStallTimeouts  map[string]string
 )
 
 const (
 	DefaultExchangeValue = "none"
 	USDExchangeValue     = "USD (Bittrex)"
+
+	// Auto lock timeouts are minutes of inactivity padded to sort in order.
+	DefaultAutoLockTimeout = "000"
+
+	// Clipboard clear delays are seconds padded to sort in order.
+	DefaultClipboardClearDelay = "060"
+
+	// Minimum password scores range from 0, no minimum, to 4.
+	DefaultMinPasswordScore = "2"
+
+	// Sync stall timeouts are seconds padded to sort in order.
+	DefaultSyncStallTimeout = "120"
 )
 
 func init() {
@@ -21,4 +37,32 @@ func init() {
 	ArrExchangeCurrencies = make(map[string]string)
 	ArrExchangeCurrencies[DefaultExchangeValue] = StrNone
 	ArrExchangeCurrencies[USDExchangeValue] = StrUsdBittrex
+
+	ArrAutoLockTimeouts = make(map[string]string)
+	ArrAutoLockTimeouts[DefaultAutoLockTimeout] = StrAutoLockNever
+	ArrAutoLockTimeouts["001"] = StrAutoLockOneMinute
+	ArrAutoLockTimeouts["005"] = StrAutoLockFiveMinutes
+	ArrAutoLockTimeouts["015"] = StrAutoLockFifteenMinutes
+	ArrAutoLockTimeouts["030"] = StrAutoLockThirtyMinutes
+	ArrAutoLockTimeouts["060"] = StrAutoLockOneHour
+
+	ArrClipboardDelays = make(map[string]string)
+	ArrClipboardDelays["000"] = StrNeverClear
+	ArrClipboardDelays["030"] = StrAfterThirtySeconds
+	ArrClipboardDelays[DefaultClipboardClearDelay] = StrAfterOneMinute
+	ArrClipboardDelays["120"] = StrAfterTwoMinutes
+	ArrClipboardDelays["300"] = StrAfterFiveMinutes
+
+	ArrMinPasswordScores = make(map[string]string)
+	ArrMinPasswordScores["0"] = StrNoMinimum
+	ArrMinPasswordScores["1"] = StrPasswordScoreWeak
+	ArrMinPasswordScores[DefaultMinPasswordScore] = StrPasswordScoreFair
+	ArrMinPasswordScores["3"] = StrPasswordScoreGood
+	ArrMinPasswordScores["4"] = StrPasswordScoreStrong
+
+	ArrSyncStallTimeouts = make(map[string]string)
+	ArrSyncStallTimeouts["060"] = StrAfterOneMinute
+	ArrSyncStallTimeouts[DefaultSyncStallTimeout] = StrAfterTwoMinutes
+	ArrSyncStallTimeouts["300"] = StrAfterFiveMinutes
+	ArrSyncStallTimeouts["600"] = StrAfterTenMinutes
 }
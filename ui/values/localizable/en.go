@@ -499,4 +499,255 @@ unlockWithPassword = "Unlock with password"
 "confirmVote" = "Confirm your vote"
 "policySetSuccessfully" = "Your treasury policy has been successfully updated!"
 "colon" = ": "
+"backup" = "Backup"
+"createWalletBackup" = "Create encrypted backup"
+"walletBackupInfo" = "The backup file contains account names, mixer and ticket buyer settings and VSP choices. It does not contain your seed."
+"backupPassword" = "Backup password"
+"confirmBackupPassword" = "Confirm backup password"
+"walletBackupSaved" = "Wallet backup saved"
+"walletBackupSavedTo" = "Your encrypted wallet backup was saved to %s"
+"restoreWalletSettings" = "Restore wallet settings"
+"restoreWalletSettingsInfo" = "Apply account names and settings from an encrypted wallet backup file?"
+"backupFilePath" = "Backup file path"
+"walletSettingsRestored" = "Wallet settings restored"
+"skip" = "Skip"
+"restoreFromSeedShares" = "Restore from seed shares"
+"seedSharesRestoreInfo" = "Enter the words of each seed share you hold, one share per field."
+"seedShareNumber" = "Seed share %d"
+"addSeedShare" = "Add share"
+"combineSeedShares" = "Combine shares"
+"invalidSeedShare" = "Invalid seed share"
+"seedSharesNeeded" = "%d more share(s) needed to recover the seed"
+"invalidSeedWords" = "Check word(s) %s, they are not valid seed words at their position"
+"seedCorrections" = "Possible corrections"
+"seedCorrectionsInfo" = "The seed phrase checksum does not match. If one of these corrections matches your backup, select it to update the seed phrase."
+"swapSeedWords" = "Swap words %d and %d"
+"replaceSeedWord" = "Replace word %d “%s” with “%s”"
+"autoLock" = "Auto lock"
+"autoLockNever" = "Never"
+"autoLockOneMinute" = "After 1 minute"
+"autoLockFiveMinutes" = "After 5 minutes"
+"autoLockFifteenMinutes" = "After 15 minutes"
+"autoLockThirtyMinutes" = "After 30 minutes"
+"autoLockOneHour" = "After 1 hour"
+"appLocked" = "godcr is locked"
+"appLockedInfo" = "The app was locked after a period of inactivity. Enter your startup password to continue."
+"passphraseRetryDelay" = "Too many failed attempts. Try again in %s"
+"invalidPassphraseRetry" = "Password entered was not valid. Try again in %s"
+"seedRequiredAfterFailures" = "Too many failed attempts. Verify your wallet seed to continue."
+"verifyWalletSeed" = "Verify wallet seed"
+"verifyWalletSeedInfo" = "Enter the seed of any wallet in this app to allow spending password attempts again."
+"walletSeedVerified" = "Wallet seed verified"
+"seedAfterPassphraseFailures" = "Require seed after %d failed spending password attempts"
+"securityAuditLog" = "Security audit log"
+"noSecurityEvents" = "No security events"
+"auditPassphraseFailed" = "%s: failed attempt %d"
+"auditPassphraseAccepted" = "%s: accepted after %d failed attempts"
+"auditSeedRequired" = "%s: wallet seed required after %d failed attempts"
+"auditSeedVerified" = "%s: wallet seed verified after %d failed attempts"
+"privacyMode" = "Privacy mode"
+"privacyModeOnFocusLoss" = "Turn on privacy mode when the window loses focus"
+"privacyModeOn" = "Privacy mode on, amounts are hidden"
+"privacyModeOff" = "Privacy mode off"
+"clearClipboard" = "Clear copied addresses and seeds"
+"afterThirtySeconds" = "After 30 seconds"
+"afterTwoMinutes" = "After 2 minutes"
+"lookalikeAddress" = "Warning: this address looks like %s, which you copied from this app, but is different. Clipboard malware may have replaced it."
+"minPasswordStrength" = "Minimum password strength"
+"noMinimum" = "No minimum"
+"passwordScoreWeak" = "Weak"
+"passwordScoreFair" = "Fair"
+"passwordScoreGood" = "Good"
+"passwordScoreStrong" = "Strong"
+"passwordTooWeak" = "This password is too easy to guess. Choose a stronger password."
+"passwordCrackTime" = "Estimated time to guess: %s"
+"lessThanASecond" = "less than a second"
+"oneSecond" = "1 second"
+"secondsCount" = "%d seconds"
+"oneMinute" = "1 minute"
+"minutesCount" = "%d minutes"
+"oneHour" = "1 hour"
+"hoursCount" = "%d hours"
+"oneDay" = "1 day"
+"daysCount" = "%d days"
+"oneMonth" = "1 month"
+"monthsCount" = "%d months"
+"oneYear" = "1 year"
+"yearsCount" = "%d years"
+"centuries" = "centuries"
+"passwordWarningCommon" = "This is a very common password."
+"passwordWarningSimilarToCommon" = "This is similar to a commonly used password."
+"passwordWarningWordByItself" = "A word by itself is easy to guess."
+"passwordWarningUserInput" = "Avoid using the wallet name in the password."
+"passwordWarningStraightRow" = "Straight rows of keys are easy to guess."
+"passwordWarningKeyboardPattern" = "Short keyboard patterns are easy to guess."
+"passwordWarningRepeat" = "Repeats like aaa or abcabc are easy to guess."
+"passwordWarningSequence" = "Sequences like abc or 6543 are easy to guess."
+"passwordWarningDate" = "Dates are often easy to guess."
+"passwordWarningRecentYear" = "Recent years are easy to guess."
+"passwordTipAddWords" = "Add another word or two. Uncommon words are better."
+"passwordTipCapitalization" = "Capitalization does not help very much."
+"passwordTipReversed" = "Reversed words are not much harder to guess."
+"passwordTipSubstitutions" = "Predictable substitutions like @ instead of a do not help very much."
+"passwordTipKeyboardPattern" = "Use a longer keyboard pattern with more turns."
+"passwordTipRepeats" = "Avoid repeated words and characters."
+"passwordTipSequences" = "Avoid sequences."
+"passwordTipDates" = "Avoid dates and years that are associated with you."
+"chooseProfile" = "Choose a profile"
+"createProfile" = "Create profile"
+"profileName" = "Profile name"
+"renameProfile" = "Rename profile"
+"removeProfile" = "Remove profile"
+"removeProfileInfo" = "%s will be removed from the list of profiles. Its wallet files are not deleted and remain in %s."
+"defaultProfile" = "Default"
+"mainnet" = "Mainnet"
+"testnet" = "Testnet"
+"profileExists" = "A profile with this name already exists."
+"blockExplorer" = "Block explorer"
+"explorerOffline" = "None (no explorer links)"
+"customExplorer" = "Custom"
+"explorerTxURL" = "Transaction URL"
+"explorerAddressURL" = "Address URL"
+"explorerBlockURL" = "Block URL"
+"explorerTemplateHint" = "Use {tx}, {address} and {height} where the transaction hash, address and block height go. Leave a URL empty to hide those links."
+"viewOnExplorer" = "View on %s"
+"explorerLinkInfo" = "Copy and paste the link below in your browser to view it on %s."
+"unavailableTorOnly" = "This feature connects directly and is not available in Tor only mode"
+"proxy" = "SOCKS5 proxy"
+"proxyOff" = "Off"
+"proxyHost" = "Proxy address (host:port)"
+"proxyUsername" = "Username (optional)"
+"proxyPassword" = "Password (optional)"
+"streamIsolation" = "Stream isolation"
+"streamIsolationInfo" = "Use a separate Tor circuit for each connection"
+"torOnly" = "Tor only"
+"torOnlyInfo" = "Refuse direct connections. Wallet sync, governance, VSPs and the DEX connect directly, so they are turned off."
+"proxySetInConfig" = "The proxy is set in the app config file and cannot be changed here."
+"proxySaved" = "Proxy settings saved"
+"offlineMode" = "Offline mode"
+"offlineModeOn" = "Offline mode is on. Turn it off in the settings to use this feature."
+"requestTimedOut" = "The request timed out. Check your connection and try again."
+"serverUnreachable" = "Unable to reach the server. Check your connection and try again."
+"tooManyRequests" = "Too many requests were made. Try again later."
+"badServerResponse" = "The server sent an unexpected response."
+"syncStalled" = "Sync stalled"
+"syncStalledInfo" = "No sync progress for %s"
+"syncFailed" = "Sync failed"
+"syncErrorReason" = "Reason"
+"nextSyncRetry" = "Next attempt"
+"retryingIn" = "In %s"
+"failedSyncAttempts" = "Failed attempts"
+"restartSync" = "Restart sync"
+"retrySyncNow" = "Retry now"
+"syncStallTimeout" = "Sync stall timeout"
+"afterTenMinutes" = "After 10 minutes"
+"networkAndPeers" = "Network and peers"
+"protocolVersion" = "Protocol version"
+"startingHeight" = "Starting height"
+"banScore" = "Ban score"
+"persistentPeers" = "Persistent peers"
+"persistentPeersInfo" = "When set, the wallets only sync with these peers."
+"noPersistentPeers" = "No persistent peers. Peers are found on the network."
+"peerAddress" = "Peer address (host:port)"
+"addPeer" = "Add peer"
+"peersSaved" = "Peers saved. They are used from the next sync."
+"peersSavedRestarting" = "Peers saved. Restarting the sync."
+"connectionEvents" = "Connection events"
+"noConnectionEvents" = "No connection events yet"
+"peerConnectedEvent" = "Connected to %s %s"
+"peerDisconnectedEvent" = "Disconnected from %s %s"
+"syncStartedEvent" = "Sync started"
+"syncStoppedEvent" = "Sync stopped"
+"syncFailedEvent" = "Sync failed: %s"
+"syncPolicy" = "Sync policy"
+"syncPolicyInfo" = "Limit when the wallets sync to save bandwidth."
+"syncContinuous" = "Continuous"
+"syncScheduled" = "Between %02d:00 and %02d:00"
+"syncScheduledMode" = "Only during set hours"
+"syncOnce" = "Sync once, then disconnect"
+"syncStartHour" = "Start hour (0-23)"
+"syncEndHour" = "End hour (0-23)"
+"invalidSyncHour" = "Enter an hour between 0 and 23"
+"meteredConnection" = "Metered connection"
+"meteredConnectionInfo" = "Pause the sync until this is turned off"
+"syncPolicySaved" = "Sync policy saved"
+"syncPaused" = "Sync paused"
+"syncPausedMetered" = "Paused on a metered connection"
+"outsideSyncHours" = "Outside of the sync hours"
+"rescanFrom" = "Rescan from"
+"rescanFromHeight" = "Block height"
+"rescanFromDate" = "Date"
+"rescanStartHeight" = "Start height"
+"rescanStartDate" = "Start date (YYYY-MM-DD)"
+"invalidRescanHeight" = "Enter a height between 0 and %d"
+"invalidRescanDate" = "Enter a past date as YYYY-MM-DD"
+"rescanStartsAt" = "Starts at block %d"
+"walletsToRescan" = "Wallets to rescan"
+"selectWalletToRescan" = "Select at least one wallet"
+"estimatedRescanTime" = "Estimated time"
+"rescanTimeUnknown" = "Known after the first rescan"
+"queuedRescans" = "Queued rescans"
+"backgroundTasks" = "Background tasks"
+"noBackgroundTasks" = "Nothing is running in the background"
+"clearFinished" = "Clear finished"
+"taskSync" = "Wallet sync"
+"taskRescan" = "Blockchain rescan"
+"taskAccountMixer" = "Account mixer"
+"taskTicketBuyer" = "Automatic ticket buyer"
+"taskPoliteiaSync" = "Proposals sync"
+"taskDexStart" = "DEX client start"
+"taskExchangeRate" = "Exchange rate fetch"
+"taskRunning" = "Running"
+"taskDone" = "Done"
+"taskCanceled" = "Canceled"
+"taskFailed" = "Failed"
+"taskCancelFailed" = "The task cannot be canceled"
+"taskBroadcast" = "Transaction broadcast"
+"finishingTasks" = "Finishing before quitting"
+"finishingTasksInfo" = "godcr quits when these operations end. Stopping them or quitting now may leave them incomplete."
+"quitNow" = "Quit now"
+"uncleanShutdown" = "godcr did not quit cleanly"
+"uncleanShutdownInfo" = "The last run of godcr, started %s, ended without shutting down. If a transaction, ticket purchase or rescan was running, check that it completed."
+"appRunning" = "godcr is already running"
+"appRunningInfo" = "Another godcr window is using the app data in %s. Opening the wallets from two windows can corrupt them."
+"showRunningApp" = "Show godcr"
+"staleAppLock" = "The app data is locked"
+"staleAppLockInfo" = "A godcr window that has closed or stopped responding left a lock on the app data in %s. Take over the lock only if no other godcr window is open."
+"takeOver" = "Take over"
+"crashReports" = "Crash reports"
+"noCrashReports" = "godcr has not crashed."
+"appCrashed" = "godcr quit after an error"
+"appCrashedInfo" = "godcr quit after an unexpected error on %s. A report of the error was saved. Sending it to the developers with a note of what you were doing helps them fix the error."
+"viewReport" = "View report"
+"crashReportSaved" = "Crash report saved"
+"crashReportSavedTo" = "The crash report was saved to %s"
+"notNow" = "Not now"
+"diagnosticsBundle" = "Create diagnostics bundle"
+"diagnosticsBundleInfo" = "These files will be added to the bundle. Secrets and addresses are removed from the config. Logs are added as they are, so look through them before sharing the bundle."
+"createBundle" = "Create bundle"
+"diagnosticsBundleSaved" = "Diagnostics bundle saved"
+"diagnosticsBundleSavedTo" = "The diagnostics bundle was saved to %s. Attach it to your bug report."
+"searchLog" = "Search the log"
+"pause" = "Pause"
+"follow" = "Follow"
+"logLevels" = "Log levels"
+"logLevelsInfo" = "Changes to the log levels last until the app quits. Set the debuglevel option in the config to keep them."
+"logEntriesShown" = "%d of %d entries"
+"levelTrace" = "Trace"
+"levelDebug" = "Debug"
+"levelInfo" = "Info"
+"levelWarn" = "Warn"
+"levelError" = "Error"
+"levelCritical" = "Critical"
+"levelOff" = "Off"
+"proxyDirectConnections" = "Only the app's own HTTP requests go through the proxy. Wallet sync, governance, VSPs and the DEX still connect directly unless Tor only is on."
+"stopTasksAndQuit" = "Stop tasks and quit"
+"ticketBuyerShutdownInfo" = "The automatic ticket buyer may be buying a ticket or paying a VSP fee, and godcr cannot tell when it is done. Stopping it or quitting now may leave a ticket not registered with its VSP."
+"afterOneMinute" = "After 1 minute"
+"afterFiveMinutes" = "After 5 minutes"
+"syncFailures" = "Sync failures"
+"syncFailuresInfo" = "Syncs that ended with an error and were restarted."
+"noSyncFailures" = "No sync failures"
+"syncFailureAttempt" = "Attempt %d: %s"
+"neverClear" = "Never"
 `
@@ -606,4 +606,255 @@ const (
 	StrConfirmVote                     = "confirmVote"
 	StrPolicySetSuccessful             = "policySetSuccessfully"
 	StrColon                           = "colon"
+	StrBackup                          = "backup"
+	StrCreateWalletBackup              = "createWalletBackup"
+	StrWalletBackupInfo                = "walletBackupInfo"
+	StrBackupPassword                  = "backupPassword"
+	StrConfirmBackupPassword           = "confirmBackupPassword"
+	StrWalletBackupSaved               = "walletBackupSaved"
+	StrWalletBackupSavedTo             = "walletBackupSavedTo"
+	StrRestoreWalletSettings           = "restoreWalletSettings"
+	StrRestoreWalletSettingsInfo       = "restoreWalletSettingsInfo"
+	StrBackupFilePath                  = "backupFilePath"
+	StrWalletSettingsRestored          = "walletSettingsRestored"
+	StrSkip                            = "skip"
+	StrRestoreFromSeedShares           = "restoreFromSeedShares"
+	StrSeedSharesRestoreInfo           = "seedSharesRestoreInfo"
+	StrSeedShareNumber                 = "seedShareNumber"
+	StrAddSeedShare                    = "addSeedShare"
+	StrCombineSeedShares               = "combineSeedShares"
+	StrInvalidSeedShare                = "invalidSeedShare"
+	StrSeedSharesNeeded                = "seedSharesNeeded"
+	StrInvalidSeedWords                = "invalidSeedWords"
+	StrSeedCorrections                 = "seedCorrections"
+	StrSeedCorrectionsInfo             = "seedCorrectionsInfo"
+	StrSwapSeedWords                   = "swapSeedWords"
+	StrReplaceSeedWord                 = "replaceSeedWord"
+	StrAutoLock                        = "autoLock"
+	StrAutoLockNever                   = "autoLockNever"
+	StrAutoLockOneMinute               = "autoLockOneMinute"
+	StrAutoLockFiveMinutes             = "autoLockFiveMinutes"
+	StrAutoLockFifteenMinutes          = "autoLockFifteenMinutes"
+	StrAutoLockThirtyMinutes           = "autoLockThirtyMinutes"
+	StrAutoLockOneHour                 = "autoLockOneHour"
+	StrAppLocked                       = "appLocked"
+	StrAppLockedInfo                   = "appLockedInfo"
+	StrPassphraseRetryDelay            = "passphraseRetryDelay"
+	StrInvalidPassphraseRetry          = "invalidPassphraseRetry"
+	StrSeedRequiredAfterFailures       = "seedRequiredAfterFailures"
+	StrVerifyWalletSeed                = "verifyWalletSeed"
+	StrVerifyWalletSeedInfo            = "verifyWalletSeedInfo"
+	StrWalletSeedVerified              = "walletSeedVerified"
+	StrSeedAfterPassphraseFailures     = "seedAfterPassphraseFailures"
+	StrSecurityAuditLog                = "securityAuditLog"
+	StrNoSecurityEvents                = "noSecurityEvents"
+	StrAuditPassphraseFailed           = "auditPassphraseFailed"
+	StrAuditPassphraseAccepted         = "auditPassphraseAccepted"
+	StrAuditSeedRequired               = "auditSeedRequired"
+	StrAuditSeedVerified               = "auditSeedVerified"
+	StrPrivacyMode                     = "privacyMode"
+	StrPrivacyModeOnFocusLoss          = "privacyModeOnFocusLoss"
+	StrPrivacyModeOn                   = "privacyModeOn"
+	StrPrivacyModeOff                  = "privacyModeOff"
+	StrClearClipboard                  = "clearClipboard"
+	StrAfterThirtySeconds              = "afterThirtySeconds"
+	StrAfterTwoMinutes                 = "afterTwoMinutes"
+	StrLookalikeAddress                = "lookalikeAddress"
+	StrMinPasswordStrength             = "minPasswordStrength"
+	StrNoMinimum                       = "noMinimum"
+	StrPasswordScoreWeak               = "passwordScoreWeak"
+	StrPasswordScoreFair               = "passwordScoreFair"
+	StrPasswordScoreGood               = "passwordScoreGood"
+	StrPasswordScoreStrong             = "passwordScoreStrong"
+	StrPasswordTooWeak                 = "passwordTooWeak"
+	StrPasswordCrackTime               = "passwordCrackTime"
+	StrLessThanASecond                 = "lessThanASecond"
+	StrOneSecond                       = "oneSecond"
+	StrSecondsCount                    = "secondsCount"
+	StrOneMinute                       = "oneMinute"
+	StrMinutesCount                    = "minutesCount"
+	StrOneHour                         = "oneHour"
+	StrHoursCount                      = "hoursCount"
+	StrOneDay                          = "oneDay"
+	StrDaysCount                       = "daysCount"
+	StrOneMonth                        = "oneMonth"
+	StrMonthsCount                     = "monthsCount"
+	StrOneYear                         = "oneYear"
+	StrYearsCount                      = "yearsCount"
+	StrCenturies                       = "centuries"
+	StrPasswordWarningCommon           = "passwordWarningCommon"
+	StrPasswordWarningSimilarToCommon  = "passwordWarningSimilarToCommon"
+	StrPasswordWarningWordByItself     = "passwordWarningWordByItself"
+	StrPasswordWarningUserInput        = "passwordWarningUserInput"
+	StrPasswordWarningStraightRow      = "passwordWarningStraightRow"
+	StrPasswordWarningKeyboardPattern  = "passwordWarningKeyboardPattern"
+	StrPasswordWarningRepeat           = "passwordWarningRepeat"
+	StrPasswordWarningSequence         = "passwordWarningSequence"
+	StrPasswordWarningDate             = "passwordWarningDate"
+	StrPasswordWarningRecentYear       = "passwordWarningRecentYear"
+	StrPasswordTipAddWords             = "passwordTipAddWords"
+	StrPasswordTipCapitalization       = "passwordTipCapitalization"
+	StrPasswordTipReversed             = "passwordTipReversed"
+	StrPasswordTipSubstitutions        = "passwordTipSubstitutions"
+	StrPasswordTipKeyboardPattern      = "passwordTipKeyboardPattern"
+	StrPasswordTipRepeats              = "passwordTipRepeats"
+	StrPasswordTipSequences            = "passwordTipSequences"
+	StrPasswordTipDates                = "passwordTipDates"
+	StrChooseProfile                   = "chooseProfile"
+	StrCreateProfile                   = "createProfile"
+	StrProfileName                     = "profileName"
+	StrRenameProfile                   = "renameProfile"
+	StrRemoveProfile                   = "removeProfile"
+	StrRemoveProfileInfo               = "removeProfileInfo"
+	StrDefaultProfile                  = "defaultProfile"
+	StrMainnet                         = "mainnet"
+	StrTestnet                         = "testnet"
+	StrProfileExists                   = "profileExists"
+	StrBlockExplorer                   = "blockExplorer"
+	StrExplorerOffline                 = "explorerOffline"
+	StrCustomExplorer                  = "customExplorer"
+	StrExplorerTxURL                   = "explorerTxURL"
+	StrExplorerAddressURL              = "explorerAddressURL"
+	StrExplorerBlockURL                = "explorerBlockURL"
+	StrExplorerTemplateHint            = "explorerTemplateHint"
+	StrViewOnExplorer                  = "viewOnExplorer"
+	StrExplorerLinkInfo                = "explorerLinkInfo"
+	StrUnavailableTorOnly              = "unavailableTorOnly"
+	StrProxy                           = "proxy"
+	StrProxyOff                        = "proxyOff"
+	StrProxyHost                       = "proxyHost"
+	StrProxyUsername                   = "proxyUsername"
+	StrProxyPassword                   = "proxyPassword"
+	StrStreamIsolation                 = "streamIsolation"
+	StrStreamIsolationInfo             = "streamIsolationInfo"
+	StrTorOnly                         = "torOnly"
+	StrTorOnlyInfo                     = "torOnlyInfo"
+	StrProxySetInConfig                = "proxySetInConfig"
+	StrProxySaved                      = "proxySaved"
+	StrOfflineMode                     = "offlineMode"
+	StrOfflineModeOn                   = "offlineModeOn"
+	StrRequestTimedOut                 = "requestTimedOut"
+	StrServerUnreachable               = "serverUnreachable"
+	StrTooManyRequests                 = "tooManyRequests"
+	StrBadServerResponse               = "badServerResponse"
+	StrSyncStalled                     = "syncStalled"
+	StrSyncStalledInfo                 = "syncStalledInfo"
+	StrSyncFailed                      = "syncFailed"
+	StrSyncErrorReason                 = "syncErrorReason"
+	StrNextSyncRetry                   = "nextSyncRetry"
+	StrRetryingIn                      = "retryingIn"
+	StrFailedSyncAttempts              = "failedSyncAttempts"
+	StrRestartSync                     = "restartSync"
+	StrRetrySyncNow                    = "retrySyncNow"
+	StrSyncStallTimeout                = "syncStallTimeout"
+	StrAfterTenMinutes                 = "afterTenMinutes"
+	StrNetworkAndPeers                 = "networkAndPeers"
+	StrProtocolVersion                 = "protocolVersion"
+	StrStartingHeight                  = "startingHeight"
+	StrBanScore                        = "banScore"
+	StrPersistentPeers                 = "persistentPeers"
+	StrPersistentPeersInfo             = "persistentPeersInfo"
+	StrNoPersistentPeers               = "noPersistentPeers"
+	StrPeerAddress                     = "peerAddress"
+	StrAddPeer                         = "addPeer"
+	StrPeersSaved                      = "peersSaved"
+	StrPeersSavedRestarting            = "peersSavedRestarting"
+	StrConnectionEvents                = "connectionEvents"
+	StrNoConnectionEvents              = "noConnectionEvents"
+	StrPeerConnectedEvent              = "peerConnectedEvent"
+	StrPeerDisconnectedEvent           = "peerDisconnectedEvent"
+	StrSyncStartedEvent                = "syncStartedEvent"
+	StrSyncStoppedEvent                = "syncStoppedEvent"
+	StrSyncFailedEvent                 = "syncFailedEvent"
+	StrSyncPolicy                      = "syncPolicy"
+	StrSyncPolicyInfo                  = "syncPolicyInfo"
+	StrSyncContinuous                  = "syncContinuous"
+	StrSyncScheduled                   = "syncScheduled"
+	StrSyncScheduledMode               = "syncScheduledMode"
+	StrSyncOnce                        = "syncOnce"
+	StrSyncStartHour                   = "syncStartHour"
+	StrSyncEndHour                     = "syncEndHour"
+	StrInvalidSyncHour                 = "invalidSyncHour"
+	StrMeteredConnection               = "meteredConnection"
+	StrMeteredConnectionInfo           = "meteredConnectionInfo"
+	StrSyncPolicySaved                 = "syncPolicySaved"
+	StrSyncPaused                      = "syncPaused"
+	StrSyncPausedMetered               = "syncPausedMetered"
+	StrOutsideSyncHours                = "outsideSyncHours"
+	StrRescanFrom                      = "rescanFrom"
+	StrRescanFromHeight                = "rescanFromHeight"
+	StrRescanFromDate                  = "rescanFromDate"
+	StrRescanStartHeight               = "rescanStartHeight"
+	StrRescanStartDate                 = "rescanStartDate"
+	StrInvalidRescanHeight             = "invalidRescanHeight"
+	StrInvalidRescanDate               = "invalidRescanDate"
+	StrRescanStartsAt                  = "rescanStartsAt"
+	StrWalletsToRescan                 = "walletsToRescan"
+	StrSelectWalletToRescan            = "selectWalletToRescan"
+	StrEstimatedRescanTime             = "estimatedRescanTime"
+	StrRescanTimeUnknown               = "rescanTimeUnknown"
+	StrQueuedRescans                   = "queuedRescans"
+	StrBackgroundTasks                 = "backgroundTasks"
+	StrNoBackgroundTasks               = "noBackgroundTasks"
+	StrClearFinished                   = "clearFinished"
+	StrTaskSync                        = "taskSync"
+	StrTaskRescan                      = "taskRescan"
+	StrTaskAccountMixer                = "taskAccountMixer"
+	StrTaskTicketBuyer                 = "taskTicketBuyer"
+	StrTaskPoliteiaSync                = "taskPoliteiaSync"
+	StrTaskDexStart                    = "taskDexStart"
+	StrTaskExchangeRate                = "taskExchangeRate"
+	StrTaskRunning                     = "taskRunning"
+	StrTaskDone                        = "taskDone"
+	StrTaskCanceled                    = "taskCanceled"
+	StrTaskFailed                      = "taskFailed"
+	StrTaskCancelFailed                = "taskCancelFailed"
+	StrTaskBroadcast                   = "taskBroadcast"
+	StrFinishingTasks                  = "finishingTasks"
+	StrFinishingTasksInfo              = "finishingTasksInfo"
+	StrQuitNow                         = "quitNow"
+	StrUncleanShutdown                 = "uncleanShutdown"
+	StrUncleanShutdownInfo             = "uncleanShutdownInfo"
+	StrAppRunning                      = "appRunning"
+	StrAppRunningInfo                  = "appRunningInfo"
+	StrShowRunningApp                  = "showRunningApp"
+	StrStaleAppLock                    = "staleAppLock"
+	StrStaleAppLockInfo                = "staleAppLockInfo"
+	StrTakeOver                        = "takeOver"
+	StrCrashReports                    = "crashReports"
+	StrNoCrashReports                  = "noCrashReports"
+	StrAppCrashed                      = "appCrashed"
+	StrAppCrashedInfo                  = "appCrashedInfo"
+	StrViewReport                      = "viewReport"
+	StrCrashReportSaved                = "crashReportSaved"
+	StrCrashReportSavedTo              = "crashReportSavedTo"
+	StrNotNow                          = "notNow"
+	StrDiagnosticsBundle               = "diagnosticsBundle"
+	StrDiagnosticsBundleInfo           = "diagnosticsBundleInfo"
+	StrCreateBundle                    = "createBundle"
+	StrDiagnosticsBundleSaved          = "diagnosticsBundleSaved"
+	StrDiagnosticsBundleSavedTo        = "diagnosticsBundleSavedTo"
+	StrSearchLog                       = "searchLog"
+	StrPause                           = "pause"
+	StrFollow                          = "follow"
+	StrLogLevels                       = "logLevels"
+	StrLogLevelsInfo                   = "logLevelsInfo"
+	StrLogEntriesShown                 = "logEntriesShown"
+	StrLevelTrace                      = "levelTrace"
+	StrLevelDebug                      = "levelDebug"
+	StrLevelInfo                       = "levelInfo"
+	StrLevelWarn                       = "levelWarn"
+	StrLevelError                      = "levelError"
+	StrLevelCritical                   = "levelCritical"
+	StrLevelOff                        = "levelOff"
+	StrProxyDirectConnections          = "proxyDirectConnections"
+	StrStopTasksAndQuit                = "stopTasksAndQuit"
+	StrTicketBuyerShutdownInfo         = "ticketBuyerShutdownInfo"
+	StrAfterOneMinute                  = "afterOneMinute"
+	StrAfterFiveMinutes                = "afterFiveMinutes"
+	StrSyncFailures                    = "syncFailures"
+	StrSyncFailuresInfo                = "syncFailuresInfo"
+	StrNoSyncFailures                  = "noSyncFailures"
+	StrSyncFailureAttempt              = "syncFailureAttempt"
+	StrNeverClear                      = "neverClear"
 )
@@ -2,17 +2,24 @@ package ui
 
 import (
 	"errors"
+	"strconv"
+	"sync"
+	"time"
 
 	giouiApp "gioui.org/app"
+	"gioui.org/io/clipboard"
 	"gioui.org/io/key"
+	"gioui.org/io/pointer"
 	"gioui.org/io/system"
 	"gioui.org/layout"
 	"gioui.org/op"
+	"gioui.org/op/clip"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
 
 	"github.com/planetdecred/dcrlibwallet"
 	"github.com/planetdecred/godcr/app"
+	"github.com/planetdecred/godcr/listeners"
 	"github.com/planetdecred/godcr/ui/assets"
 	"github.com/planetdecred/godcr/ui/decredmaterial"
 	"github.com/planetdecred/godcr/ui/load"
@@ -22,6 +29,14 @@ import (
 	"github.com/planetdecred/godcr/wallet"
 )
 
+// autoLockCheckInterval is how often the window checks whether it has been
+// idle for longer than the auto lock timeout and whether sensitive text
+// should be cleared from the clipboard.
+const autoLockCheckInterval = 5 * time.Second
+
+// privacyModeShortcut toggles privacy mode from any page or modal.
+const privacyModeShortcut = key.Set("Short-Shift-H")
+
 // Window represents the app window (and UI in general). There should only be one.
 // Window maintains an internal state of variables to determine what to display at
 // any point in time.
@@ -37,6 +52,17 @@ type Window struct {
 	txAuthor dcrlibwallet.TxAuthor
 
 	walletAcctMixerStatus chan *wallet.AccountMixer
+
+	// lastActivity is the time of the last pointer, key or editor input on
+	// the window, or of the last unlock of the window.
+	activityMu   sync.Mutex
+	lastActivity time.Time
+
+	// OnCrash is called after the report of a panic is written and the
+	// wallets are shut down, before the app quits.
+	OnCrash func()
+
+	crashOnce sync.Once
 }
 
 type (
@@ -53,20 +79,14 @@ type WriteClipboard struct {
 // app.NewWindow() which does not support being called more
 // than once.
 func CreateWindow(wal *wallet.Wallet) (*Window, error) {
-	var netType string
-	if wal.Net == dcrlibwallet.Testnet3 {
-		netType = "testnet"
-	} else {
-		netType = wal.Net
-	}
-
-	giouiWindow := giouiApp.NewWindow(giouiApp.MinSize(values.AppWidth, values.AppHeight), giouiApp.Title(values.StringF(values.StrAppTitle, netType)))
+	giouiWindow := giouiApp.NewWindow(giouiApp.MinSize(values.AppWidth, values.AppHeight), giouiApp.Title(windowTitle(wal.Net)))
 	win := &Window{
 		Window:                giouiWindow,
 		navigator:             app.NewSimpleWindowNavigator(giouiWindow.Invalidate),
 		wallet:                wal,
 		walletUnspentOutputs:  new(wallet.UnspentOutputs),
 		walletAcctMixerStatus: make(chan *wallet.AccountMixer),
+		lastActivity:          time.Now(),
 	}
 
 	l, err := win.NewLoad()
@@ -78,17 +98,44 @@ func CreateWindow(wal *wallet.Wallet) (*Window, error) {
 	return win, nil
 }
 
+// UseLogLevels lets the log page show the log level of each subsystem with
+// levels and change them with set, which takes a value of the debuglevel
+// option.
+func (win *Window) UseLogLevels(levels func() map[string]string, set func(debugLevel string) error) {
+	win.load.LogLevels = levels
+	win.load.SetLogLevels = set
+}
+
+// windowTitle returns the title of the app window when net is in use.
+func windowTitle(net string) string {
+	if net == dcrlibwallet.Testnet3 {
+		net = "testnet"
+	}
+	return values.StringF(values.StrAppTitle, net)
+}
+
 func (win *Window) NewLoad() (*load.Load, error) {
 	th := decredmaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
 	if th == nil {
 		return nil, errors.New("unexpected error while loading theme")
 	}
 
+	// The multiwallet is not loaded yet if an app profile is to be chosen on
+	// the start page.
 	mw := win.wallet.GetMultiWallet()
 
 	// Set the user-configured theme colors on app load.
-	isDarkModeOn := mw.ReadBoolConfigValueForKey(load.DarkModeConfigKey, false)
-	th.SwitchDarkMode(isDarkModeOn, assets.DecredIcons)
+	if mw != nil {
+		isDarkModeOn := mw.ReadBoolConfigValueForKey(load.DarkModeConfigKey, false)
+		th.SwitchDarkMode(isDarkModeOn, assets.DecredIcons)
+	}
+
+	bus := listeners.NewBus()
+	if mw != nil {
+		if err := bus.Attach(win.wallet); err != nil {
+			return nil, err
+		}
+	}
 
 	l := &load.Load{
 		Theme: th,
@@ -101,6 +148,7 @@ func (win *Window) NewLoad() (*load.Load, error) {
 		},
 
 		Toast: notification.NewToast(th),
+		Bus:   bus,
 
 		Printer: message.NewPrinter(language.English),
 	}
@@ -130,35 +178,152 @@ func (win *Window) NewLoad() (*load.Load, error) {
 		}
 	}
 
+	l.ProfileSelected = func() {
+		l.WL.MultiWallet = win.wallet.GetMultiWallet()
+		if err := l.Bus.Attach(win.wallet); err != nil {
+			log.Errorf("Error attaching event bus: %v", err)
+		}
+		win.Option(giouiApp.Title(windowTitle(win.wallet.Net)))
+		l.RefreshTheme(win.navigator)
+	}
+
+	l.Crashed = win.crash
+
 	return l, nil
 }
 
 // HandleEvents runs main event handling and page rendering loop.
 func (win *Window) HandleEvents() {
+	autoLockTicker := time.NewTicker(autoLockCheckInterval)
+	defer autoLockTicker.Stop()
 
 	for {
-		e := <-win.Events()
-		switch evt := e.(type) {
+		select {
+		case e := <-win.Events():
+			switch evt := e.(type) {
 
-		case system.DestroyEvent:
-			win.navigator.CloseAllPages()
-			return // exits the loop, caller will exit the program.
+			case system.DestroyEvent:
+				win.navigator.CloseAllPages()
+				return // exits the loop, caller will exit the program.
 
-		case system.FrameEvent:
-			ops := win.handleFrameEvent(evt)
-			evt.Frame(ops)
+			case system.FrameEvent:
+				ops := win.handleFrameEvent(evt)
+				evt.Frame(ops)
 
-		default:
-			log.Tracef("Unhandled window event %v\n", e)
+			default:
+				log.Tracef("Unhandled window event %v\n", e)
+			}
+
+		case <-autoLockTicker.C:
+			win.lockIfIdle()
+			if win.load.ClipboardClearDue() {
+				// The clipboard is read on the next frame.
+				win.Invalidate()
+			}
 		}
 	}
 }
 
+// autoLockTimeout returns the user-configured period of inactivity after
+// which the window is locked, or 0 if auto lock is disabled. Auto lock
+// requires a startup password to unlock the window.
+func (win *Window) autoLockTimeout() time.Duration {
+	mw := win.wallet.GetMultiWallet()
+	if mw == nil || !mw.IsStartupSecuritySet() {
+		return 0
+	}
+
+	minutes, err := strconv.Atoi(mw.ReadStringConfigValueForKey(load.AutoLockTimeoutConfigKey))
+	if err != nil {
+		return 0
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// recordActivity restarts the auto lock timer. It may be called from any
+// goroutine.
+func (win *Window) recordActivity() {
+	win.activityMu.Lock()
+	win.lastActivity = time.Now()
+	win.activityMu.Unlock()
+}
+
+// idleTime returns the time since the last activity on the window.
+func (win *Window) idleTime() time.Duration {
+	win.activityMu.Lock()
+	defer win.activityMu.Unlock()
+	return time.Since(win.lastActivity)
+}
+
+// lockIfIdle displays the app lock page over the current page if there was
+// no user input for longer than the auto lock timeout. Any displayed modal
+// is dismissed since it may show a seed or hold a passphrase.
+func (win *Window) lockIfIdle() {
+	timeout := win.autoLockTimeout()
+	if timeout == 0 || win.idleTime() < timeout {
+		return
+	}
+
+	// The startup password is entered on the start page.
+	switch win.navigator.CurrentPageID() {
+	case "", page.StartPageID, page.AppLockPageID:
+		return
+	}
+
+	log.Info("Locking app after inactivity")
+	for modal := win.navigator.TopModal(); modal != nil; modal = win.navigator.TopModal() {
+		win.navigator.DismissModal(modal.ID())
+	}
+	win.navigator.Display(page.NewAppLockPage(win.load, win.recordActivity))
+}
+
+// handleFocusLoss turns on privacy mode when the window loses focus if the
+// user opted for it, so that amounts aren't visible while another app is in use.
+func (win *Window) handleFocusLoss() {
+	if win.wallet.GetMultiWallet() == nil || win.load.IsPrivacyModeOn() {
+		return
+	}
+
+	if win.wallet.GetMultiWallet().ReadBoolConfigValueForKey(load.PrivacyOnFocusLossConfigKey, false) {
+		win.load.SetPrivacyMode(true)
+		win.Invalidate()
+	}
+}
+
+// togglePrivacyMode turns privacy mode on or off and notifies the user.
+func (win *Window) togglePrivacyMode() {
+	isOn := !win.load.IsPrivacyModeOn()
+	win.load.SetPrivacyMode(isOn)
+	if isOn {
+		win.load.Toast.Notify(values.String(values.StrPrivacyModeOn))
+	} else {
+		win.load.Toast.Notify(values.String(values.StrPrivacyModeOff))
+	}
+}
+
 // handleFrameEvent is called when a FrameEvent is received by the active
 // window. It expects a new frame in the form of a list of operations that
 // describes what to display and how to handle input. This operations list
 // is returned to the caller for displaying on screen.
 func (win *Window) handleFrameEvent(evt system.FrameEvent) *op.Ops {
+	defer win.recoverPanic()
+
+	for _, e := range evt.Queue.Events(win) {
+		switch e := e.(type) {
+		case clipboard.Event:
+			if win.load.ShouldClearClipboard(e.Text) {
+				win.WriteClipboard("")
+			}
+		case key.Event:
+			win.recordActivity()
+			if e.State == key.Press && win.wallet.GetMultiWallet() != nil {
+				win.togglePrivacyMode()
+			}
+		default:
+			win.recordActivity()
+		}
+	}
+
 	switch {
 	case win.navigator.CurrentPage() == nil:
 		// Prepare to display the StartPage if no page is currently displayed.
@@ -196,6 +361,7 @@ func (win *Window) handleRelevantKeyPresses(evt system.FrameEvent) {
 		}
 		for _, event := range evt.Queue.Events(tag) {
 			if keyEvent, isKeyEvent := event.(key.Event); isKeyEvent && keyEvent.State == key.Press {
+				win.recordActivity()
 				handler.HandleKeyPress(&keyEvent)
 			}
 		}
@@ -246,6 +412,35 @@ func (win *Window) prepareToDisplayUI(evt system.FrameEvent) *op.Ops {
 		layout.Stacked(win.load.Toast.Layout),
 	)
 
+	// Listen for pointer input anywhere on the window to reset the auto lock
+	// timer. The events are passed through to the UI components underneath.
+	passStack := pointer.PassOp{}.Push(ops)
+	areaStack := clip.Rect{Max: gtx.Constraints.Max}.Push(ops)
+	pointer.InputOp{
+		Tag:   win,
+		Types: pointer.Press | pointer.Release | pointer.Move | pointer.Drag,
+	}.Add(ops)
+	areaStack.Pop()
+	passStack.Pop()
+
+	// The window focus is told to the focused editor or to the window focus
+	// tag, so it is checked after the pages are laid out.
+	// Typing in an editor is told to the editor only, so the editors report
+	// changes to their text or caret through the window focus.
+	windowFocus := win.load.Theme.WindowFocus()
+	if windowFocus.Frame(gtx) {
+		win.handleFocusLoss()
+	}
+	if windowFocus.Edited() {
+		win.recordActivity()
+	}
+
+	// Read the clipboard to check whether it still holds the sensitive text
+	// copied by the app before clearing it.
+	if win.load.ClipboardClearDue() {
+		clipboard.ReadOp{Tag: win}.Add(ops)
+	}
+
 	return ops
 }
 
@@ -269,6 +464,11 @@ func (win *Window) addKeyEventRequestsToOps(ops *op.Ops) {
 		op.Defer(ops, m.Stop())
 	}
 
+	// The privacy mode shortcut is handled by the window itself.
+	m := op.Record(ops)
+	key.InputOp{Tag: win, Keys: privacyModeShortcut}.Add(ops)
+	op.Defer(ops, m.Stop())
+
 	// Request key events on the top modal, if necessary.
 	// Only request key events on the current page if no modal is displayed.
 	if modal := win.navigator.TopModal(); modal != nil {
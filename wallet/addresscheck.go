@@ -0,0 +1,40 @@
+package wallet
+
+import "strings"
+
+const (
+	// lookalikePrefixLength is the number of leading characters compared when
+	// checking for look-alike addresses. It includes the two characters of the
+	// network prefix which are the same for every address.
+	lookalikePrefixLength = 5
+	// lookalikeSuffixLength is the number of trailing characters compared when
+	// checking for look-alike addresses.
+	lookalikeSuffixLength = 4
+)
+
+// LookalikeAddress returns the address in known that address may have been
+// substituted for. Clipboard hijacking malware replaces copied addresses with
+// ones that start and end with the same characters, since those are the parts
+// people usually check. It returns false if address matches no known address
+// that way or if address is itself a known address.
+func LookalikeAddress(address string, known []string) (string, bool) {
+	address = strings.TrimSpace(address)
+	if len(address) < lookalikePrefixLength+lookalikeSuffixLength {
+		return "", false
+	}
+
+	var lookalike string
+	for _, knownAddress := range known {
+		if knownAddress == address {
+			return "", false
+		}
+		if len(knownAddress) < lookalikePrefixLength+lookalikeSuffixLength {
+			continue
+		}
+		if address[:lookalikePrefixLength] == knownAddress[:lookalikePrefixLength] &&
+			address[len(address)-lookalikeSuffixLength:] == knownAddress[len(knownAddress)-lookalikeSuffixLength:] {
+			lookalike = knownAddress
+		}
+	}
+	return lookalike, lookalike != ""
+}
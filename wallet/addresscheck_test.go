@@ -0,0 +1,30 @@
+package wallet
+
+import "testing"
+
+func TestLookalikeAddress(t *testing.T) {
+	known := []string{
+		"DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
+		"DsSWTHFrvmsnvWvfPo8ad2oJ4Ch3jwVyRBd",
+	}
+
+	tests := []struct {
+		name      string
+		address   string
+		lookalike string
+	}{
+		{"copied address", "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu", ""},
+		{"unrelated address", "DsQxuVRvS4eaJ42dhQEsCXauMWjvopWgrVg", ""},
+		{"same prefix only", "DsUZxBNHHAmyoZLSTsS6UKJmgYnfSXL2jLk", ""},
+		{"substituted middle", "DsUZxhrhB7gXGsSgCp9PPEx8ZvaknUT7tJu", "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu"},
+		{"surrounding whitespace", " DsSWTaWZWkC8NH2XrhCV1T7GpLYvAuEyRBd\n", "DsSWTHFrvmsnvWvfPo8ad2oJ4Ch3jwVyRBd"},
+		{"too short", "DsU", ""},
+	}
+
+	for _, test := range tests {
+		lookalike, ok := LookalikeAddress(test.address, known)
+		if lookalike != test.lookalike || ok != (test.lookalike != "") {
+			t.Errorf("%s: got %q, %v, want %q", test.name, lookalike, ok, test.lookalike)
+		}
+	}
+}
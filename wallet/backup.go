@@ -0,0 +1,378 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/planetdecred/dcrlibwallet"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// WalletBackupVersion is the current version of the encrypted wallet
+	// backup file format.
+	WalletBackupVersion = 1
+
+	// WalletBackupFileExt is the extension used for wallet backup files.
+	WalletBackupFileExt = ".gdcrbackup"
+
+	backupDirName = "backups"
+
+	// scrypt parameters used to derive the backup encryption key from the
+	// backup passphrase.
+	backupScryptN      = 1 << 15
+	backupScryptR      = 8
+	backupScryptP      = 1
+	backupSaltSize     = 32
+	backupNonceSize    = 24
+	backupKeySize      = 32
+	backupMagicVersion = "godcr-wallet-backup"
+)
+
+var (
+	// ErrBackupChecksum is returned when the encrypted payload of a backup
+	// does not match the checksum recorded in the backup file, which means
+	// the file is corrupted.
+	ErrBackupChecksum = errors.New("wallet backup checksum mismatch")
+
+	// ErrBackupVersion is returned when a backup file was created by a newer
+	// version of the app.
+	ErrBackupVersion = errors.New("unsupported wallet backup version")
+
+	// ErrBackupNetwork is returned when a backup is applied to a wallet on a
+	// different network than the one it was created on.
+	ErrBackupNetwork = errors.New("wallet backup was created on a different network")
+)
+
+// walletBackupConfigKeys are the dcrlibwallet per-wallet config keys that are
+// always included in a wallet backup.
+var walletBackupConfigKeys = []string{
+	dcrlibwallet.AccountMixerConfigSet,
+	dcrlibwallet.AccountMixerMixedAccount,
+	dcrlibwallet.AccountMixerUnmixedAccount,
+	dcrlibwallet.AccountMixerMixTxChange,
+	dcrlibwallet.TicketBuyerVSPHostConfigKey,
+	dcrlibwallet.TicketBuyerAccountConfigKey,
+	dcrlibwallet.TicketBuyerATMConfigKey,
+}
+
+// BackupAccount is an account name saved in a wallet backup.
+type BackupAccount struct {
+	Number int32  `json:"number"`
+	Name   string `json:"name"`
+}
+
+// BackupVSPs mirrors the known VSP data dcrlibwallet stores under
+// dcrlibwallet.KnownVSPsConfigKey.
+type BackupVSPs struct {
+	SavedHosts  []string
+	LastUsedVSP string
+}
+
+// WalletBackup holds the private settings of a wallet that cannot be
+// recovered from its seed.
+type WalletBackup struct {
+	Version    uint32                     `json:"version"`
+	Network    string                     `json:"network"`
+	CreatedAt  int64                      `json:"created_at"`
+	WalletName string                     `json:"wallet_name"`
+	Accounts   []BackupAccount            `json:"accounts"`
+	VSPs       BackupVSPs                 `json:"vsps"`
+	Settings   map[string]json.RawMessage `json:"settings"`
+}
+
+// walletBackupFile is the on-disk format of an encrypted wallet backup.
+type walletBackupFile struct {
+	Magic   string `json:"magic"`
+	Version uint32 `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	// Checksum is the SHA-256 of Payload. It tells a corrupted file apart
+	// from a wrong passphrase without revealing anything about the
+	// plaintext.
+	Checksum []byte `json:"checksum"`
+	Payload  []byte `json:"payload"`
+}
+
+// ExportWalletBackup collects the account names, mixer and ticket buyer
+// settings and VSP choices of the provided wallet. Any extra per-wallet config
+// keys set by the app are included in the backup as well.
+func (wal *Wallet) ExportWalletBackup(w *dcrlibwallet.Wallet, extraConfigKeys ...string) (*WalletBackup, error) {
+	accountsResult, err := w.GetAccountsRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	backup := &WalletBackup{
+		Version:    WalletBackupVersion,
+		Network:    wal.Net,
+		CreatedAt:  time.Now().Unix(),
+		WalletName: w.Name,
+		Settings:   make(map[string]json.RawMessage),
+	}
+
+	for _, account := range accountsResult.Acc {
+		if account.Number == dcrlibwallet.ImportedAccountNumber {
+			continue
+		}
+		backup.Accounts = append(backup.Accounts, BackupAccount{Number: account.Number, Name: account.Name})
+	}
+
+	keys := append(append([]string{}, walletBackupConfigKeys...), extraConfigKeys...)
+	for _, key := range keys {
+		var value json.RawMessage
+		if err := w.ReadUserConfigValue(key, &value); err == nil && len(value) > 0 {
+			backup.Settings[key] = value
+		}
+	}
+
+	wal.multi.ReadUserConfigValue(dcrlibwallet.KnownVSPsConfigKey, &backup.VSPs)
+
+	return backup, nil
+}
+
+// MissingAccounts returns the number of accounts in the backup that do not yet
+// exist in the provided wallet. Creating missing accounts requires the wallet's
+// private passphrase.
+func (backup *WalletBackup) MissingAccounts(w *dcrlibwallet.Wallet) int {
+	var missing int
+	for _, account := range backup.Accounts {
+		if _, err := w.AccountName(account.Number); err != nil {
+			missing++
+		}
+	}
+	return missing
+}
+
+// ApplyWalletBackup restores the account names, mixer and ticket buyer settings
+// and VSP choices saved in backup to the provided wallet. Accounts that do not
+// exist yet are created, which requires privPass; privPass may be nil if the
+// backup has no missing accounts.
+func (wal *Wallet) ApplyWalletBackup(w *dcrlibwallet.Wallet, backup *WalletBackup, privPass []byte) error {
+	if backup.Version > WalletBackupVersion {
+		return ErrBackupVersion
+	}
+	if backup.Network != wal.Net {
+		return ErrBackupNetwork
+	}
+
+	accounts := append([]BackupAccount{}, backup.Accounts...)
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Number < accounts[j].Number
+	})
+
+	if backup.MissingAccounts(w) > 0 {
+		if err := w.UnlockWallet(privPass); err != nil {
+			return err
+		}
+		defer w.LockWallet()
+	}
+
+	for _, account := range accounts {
+		name, err := w.AccountName(account.Number)
+		if err != nil {
+			// Accounts are derived sequentially, create every account up
+			// to and including the missing account number.
+			if err := createAccountsUpTo(w, account.Number); err != nil {
+				return err
+			}
+			name = ""
+		}
+
+		if name == account.Name {
+			continue
+		}
+		if err := w.RenameAccount(account.Number, account.Name); err != nil {
+			return fmt.Errorf("rename account %d: %v", account.Number, err)
+		}
+	}
+
+	for key, value := range backup.Settings {
+		w.SaveUserConfigValue(key, value)
+	}
+
+	if len(backup.VSPs.SavedHosts) > 0 || backup.VSPs.LastUsedVSP != "" {
+		var current BackupVSPs
+		wal.multi.ReadUserConfigValue(dcrlibwallet.KnownVSPsConfigKey, &current)
+		for _, host := range backup.VSPs.SavedHosts {
+			if !containsString(current.SavedHosts, host) {
+				current.SavedHosts = append(current.SavedHosts, host)
+			}
+		}
+		if current.LastUsedVSP == "" {
+			current.LastUsedVSP = backup.VSPs.LastUsedVSP
+		}
+		wal.multi.SaveUserConfigValue(dcrlibwallet.KnownVSPsConfigKey, current)
+	}
+
+	return nil
+}
+
+// createAccountsUpTo creates placeholder accounts on the unlocked wallet w
+// until an account with the provided number exists.
+func createAccountsUpTo(w *dcrlibwallet.Wallet, accountNumber int32) error {
+	for {
+		number, err := w.NextAccount(fmt.Sprintf("account-%d", time.Now().UnixNano()))
+		if err != nil {
+			return fmt.Errorf("create account %d: %v", accountNumber, err)
+		}
+		if number >= accountNumber {
+			return nil
+		}
+	}
+}
+
+// EncryptWalletBackup serializes backup and encrypts it with a key derived
+// from passphrase.
+func EncryptWalletBackup(backup *WalletBackup, passphrase []byte) ([]byte, error) {
+	payload, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &walletBackupFile{
+		Magic:   backupMagicVersion,
+		Version: WalletBackupVersion,
+		Salt:    make([]byte, backupSaltSize),
+		Nonce:   make([]byte, backupNonceSize),
+	}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return nil, err
+	}
+
+	key, err := backupKey(passphrase, file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce [backupNonceSize]byte
+	copy(nonce[:], file.Nonce)
+	file.Payload = secretbox.Seal(nil, payload, &nonce, key)
+
+	checksum := sha256.Sum256(file.Payload)
+	file.Checksum = checksum[:]
+
+	return json.MarshalIndent(file, "", "  ")
+}
+
+// DecryptWalletBackup decrypts and verifies a wallet backup created by
+// EncryptWalletBackup.
+func DecryptWalletBackup(data, passphrase []byte) (*WalletBackup, error) {
+	file := new(walletBackupFile)
+	if err := json.Unmarshal(data, file); err != nil || file.Magic != backupMagicVersion {
+		return nil, errors.New("not a wallet backup file")
+	}
+	if file.Version > WalletBackupVersion {
+		return nil, ErrBackupVersion
+	}
+	if len(file.Nonce) != backupNonceSize {
+		return nil, errors.New("invalid wallet backup nonce")
+	}
+
+	checksum := sha256.Sum256(file.Payload)
+	if !bytes.Equal(checksum[:], file.Checksum) {
+		return nil, ErrBackupChecksum
+	}
+
+	key, err := backupKey(passphrase, file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce [backupNonceSize]byte
+	copy(nonce[:], file.Nonce)
+	payload, ok := secretbox.Open(nil, file.Payload, &nonce, key)
+	if !ok {
+		return nil, ErrBadPass
+	}
+
+	backup := new(WalletBackup)
+	if err := json.Unmarshal(payload, backup); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+// SaveWalletBackup encrypts backup with passphrase and writes it to the
+// backups directory in the app data directory. The path of the written file
+// is returned.
+func (wal *Wallet) SaveWalletBackup(backup *WalletBackup, passphrase []byte) (string, error) {
+	data, err := EncryptWalletBackup(backup, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(wal.Root, backupDirName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%s-%s%s", backupFileName(backup.WalletName), time.Unix(backup.CreatedAt, 0).Format("20060102-150405"), WalletBackupFileExt)
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return "", err
+	}
+
+	log.Infof("Wallet backup saved to %s", path)
+	return path, nil
+}
+
+// backupFileName returns walletName with the characters that are not letters,
+// digits, '-' or '_' replaced, so it can be used in a file name without
+// leaving the backups directory.
+func backupFileName(walletName string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, walletName)
+	if strings.Trim(name, "_") == "" {
+		return "wallet"
+	}
+	return name
+}
+
+// LoadWalletBackup reads and decrypts the wallet backup at path.
+func LoadWalletBackup(path string, passphrase []byte) (*WalletBackup, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptWalletBackup(data, passphrase)
+}
+
+func backupKey(passphrase, salt []byte) (*[backupKeySize]byte, error) {
+	derived, err := scrypt.Key(passphrase, salt, backupScryptN, backupScryptR, backupScryptP, backupKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	var key [backupKeySize]byte
+	copy(key[:], derived)
+	return &key, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
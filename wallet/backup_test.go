@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWalletBackupEncryption(t *testing.T) {
+	backup := &WalletBackup{
+		Version:    WalletBackupVersion,
+		Network:    "testnet3",
+		WalletName: "savings",
+		Accounts:   []BackupAccount{{Number: 0, Name: "default"}, {Number: 1, Name: "mixed"}},
+		VSPs:       BackupVSPs{SavedHosts: []string{"https://vsp.example.org"}},
+		Settings:   map[string]json.RawMessage{"tb_vsp_host": json.RawMessage(`"https://vsp.example.org"`)},
+	}
+
+	data, err := EncryptWalletBackup(backup, []byte("passphrase"))
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+
+	if _, err := DecryptWalletBackup(data, []byte("wrong")); err != ErrBadPass {
+		t.Fatalf("expected ErrBadPass for a wrong passphrase, got %v", err)
+	}
+
+	restored, err := DecryptWalletBackup(data, []byte("passphrase"))
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	if restored.WalletName != backup.WalletName || len(restored.Accounts) != 2 || restored.Accounts[1].Name != "mixed" {
+		t.Fatalf("unexpected restored backup %+v", restored)
+	}
+	if string(restored.Settings["tb_vsp_host"]) != `"https://vsp.example.org"` {
+		t.Fatalf("unexpected restored settings %v", restored.Settings)
+	}
+
+	file := new(walletBackupFile)
+	if err := json.Unmarshal(data, file); err != nil {
+		t.Fatal(err)
+	}
+	// A corrupted payload is reported as such, even with a wrong passphrase.
+	file.Payload[0] ^= 0xff
+	tampered, _ := json.Marshal(file)
+	if _, err := DecryptWalletBackup(tampered, []byte("wrong")); err != ErrBackupChecksum {
+		t.Fatalf("expected ErrBackupChecksum, got %v", err)
+	}
+}
+
+func TestBackupFileName(t *testing.T) {
+	tests := map[string]string{
+		"Default":     "Default",
+		"my wallet":   "my_wallet",
+		"../../etc":   "______etc",
+		`a\b/c`:       "a_b_c",
+		"..":          "wallet",
+		"":            "wallet",
+		"savings-2_β": "savings-2_β",
+	}
+	for walletName, want := range tests {
+		if got := backupFileName(walletName); got != want {
+			t.Errorf("backupFileName(%q): got %q, want %q", walletName, got, want)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// crashDirName is the directory in the log directory that crash reports
+	// are written to.
+	crashDirName = "crashes"
+
+	// maxCrashReports is the number of crash reports kept. The oldest are
+	// removed when more are written.
+	maxCrashReports = 10
+
+	crashFilePrefix = "crash-"
+	crashFileSuffix = ".json"
+
+	// seenCrashFileSuffix replaces crashFileSuffix once the user was told
+	// of the crash.
+	seenCrashFileSuffix = ".seen.json"
+
+	// unseenCrashFilePattern and seenCrashFilePattern match the reports
+	// the user was not and was told of. The time in the name of a report
+	// ends with a digit, which keeps the first from matching seen reports.
+	unseenCrashFilePattern = crashFilePrefix + "*[0-9]" + crashFileSuffix
+	seenCrashFilePattern   = crashFilePrefix + "*" + seenCrashFileSuffix
+)
+
+// crashState holds the values of a crash report that can't be read without
+// locks. The crash handler runs on the goroutine that panicked, which may
+// hold one of those locks, so it reads these instead.
+type crashState struct {
+	// config is the crashConfig of the loaded wallets, cached when they
+	// are loaded and when their settings change.
+	config atomic.Value
+
+	// syncing, synced and rescanning are 1 while the wallets are syncing,
+	// synced and rescanning. They are set by the sync and rescan listeners.
+	syncing    uint32
+	synced     uint32
+	rescanning uint32
+}
+
+func setFlag(flag *uint32, on bool) {
+	var v uint32
+	if on {
+		v = 1
+	}
+	atomic.StoreUint32(flag, v)
+}
+
+func flagSet(flag *uint32) bool {
+	return atomic.LoadUint32(flag) == 1
+}
+
+// CrashReport describes a panic of the app.
+type CrashReport struct {
+	Time      time.Time `json:"time"`
+	Version   string    `json:"version"`
+	BuildDate time.Time `json:"build_date"`
+	GoVersion string    `json:"go_version"`
+	OS        string    `json:"os"`
+	Uptime    string    `json:"uptime"`
+
+	Panic string `json:"panic"`
+	Stack string `json:"stack"`
+
+	// Pages are the IDs of the displayed pages, the bottom of the page
+	// stack first.
+	Pages []string `json:"pages"`
+
+	// Config is the app config with the values that identify the user,
+	// such as the proxy address and credentials, left out.
+	Config map[string]string `json:"config"`
+
+	// Name is the file name of the report and Seen is true once the user
+	// was told of the crash.
+	Name string `json:"-"`
+	Seen bool   `json:"-"`
+}
+
+// NewCrashReport returns the report of a panic with panicValue. stack is the
+// stack trace of the goroutine that panicked and pages are the IDs of the
+// displayed pages.
+func (wal *Wallet) NewCrashReport(panicValue interface{}, stack []byte, pages []string) *CrashReport {
+	now := time.Now()
+	return &CrashReport{
+		Time:      now,
+		Version:   wal.version,
+		BuildDate: wal.buildDate,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS + "/" + runtime.GOARCH,
+		Uptime:    now.Sub(wal.startUpTime).Round(time.Second).String(),
+		Panic:     fmt.Sprint(panicValue),
+		Stack:     string(stack),
+		Pages:     pages,
+		Config:    wal.cachedCrashConfig(),
+	}
+}
+
+// cacheCrashConfig caches the crashConfig of the loaded wallets for the crash
+// handler. It must not be called from the sync or rescan listeners, since
+// crashConfig takes the locks they are called with.
+func (wal *Wallet) cacheCrashConfig() {
+	wal.crashState.config.Store(wal.crashConfig())
+}
+
+// cachedCrashConfig returns the cached crashConfig with the current sync
+// state. It takes no locks.
+func (wal *Wallet) cachedCrashConfig() map[string]string {
+	cfg := make(map[string]string)
+	cached, _ := wal.crashState.config.Load().(map[string]string)
+	for key, value := range cached {
+		cfg[key] = value
+	}
+	if _, loaded := cfg["wallets"]; loaded {
+		cfg["synced"] = strconv.FormatBool(flagSet(&wal.crashState.synced))
+		cfg["syncing"] = strconv.FormatBool(flagSet(&wal.crashState.syncing))
+		cfg["rescanning"] = strconv.FormatBool(flagSet(&wal.crashState.rescanning))
+	}
+	return cfg
+}
+
+// crashConfig returns the config values that help find the cause of a crash.
+// It reads the settings and sync state of the loaded wallets, which takes
+// their locks; the crash handler uses cachedCrashConfig instead.
+func (wal *Wallet) crashConfig() map[string]string {
+	proxy := wal.ProxyConfig()
+	cfg := map[string]string{
+		"network":          wal.Net,
+		"default_profile":  strconv.FormatBool(wal.profileName == DefaultProfileName),
+		"proxy":            strconv.FormatBool(proxy.Enabled()),
+		"proxy_overridden": strconv.FormatBool(wal.ProxyOverridden()),
+		"stream_isolation": strconv.FormatBool(proxy.StreamIsolation),
+		"tor_only":         strconv.FormatBool(proxy.TorOnly),
+		"wallets_loaded":   strconv.FormatBool(wal.multi != nil),
+	}
+	if wal.multi == nil {
+		return cfg
+	}
+
+	policy := wal.SyncPolicy()
+	cfg["sync_mode"] = strconv.Itoa(int(policy.Mode))
+	cfg["sync_metered"] = strconv.FormatBool(policy.Metered)
+	cfg["wallets"] = strconv.Itoa(int(wal.multi.LoadedWalletsCount()))
+	cfg["synced"] = strconv.FormatBool(wal.multi.IsSynced())
+	cfg["syncing"] = strconv.FormatBool(wal.multi.IsSyncing())
+	cfg["rescanning"] = strconv.FormatBool(wal.multi.IsRescanning())
+	return cfg
+}
+
+// crashDir returns the directory crash reports are written to.
+func (wal *Wallet) crashDir() string {
+	if wal.logFile == "" {
+		return filepath.Join(wal.homeDir, crashDirName)
+	}
+	return filepath.Join(filepath.Dir(wal.logFile), crashDirName)
+}
+
+// SaveCrashReport writes report to the crash directory in the log directory.
+func (wal *Wallet) SaveCrashReport(report *CrashReport) error {
+	dir := wal.crashDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	report.Name = crashFilePrefix + report.Time.Format("20060102-150405.000") + crashFileSuffix
+	if err = os.WriteFile(filepath.Join(dir, report.Name), data, 0600); err != nil {
+		return err
+	}
+
+	wal.pruneCrashReports()
+	return nil
+}
+
+// CrashReports returns the saved crash reports, the most recent first.
+func (wal *Wallet) CrashReports() ([]*CrashReport, error) {
+	dir := wal.crashDir()
+	var reports []*CrashReport
+	for _, seen := range []bool{false, true} {
+		pattern := unseenCrashFilePattern
+		if seen {
+			pattern = seenCrashFilePattern
+		}
+		files, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			report := new(CrashReport)
+			if err = json.Unmarshal(data, report); err != nil {
+				log.Warnf("Skipping unreadable crash report %s: %v", file, err)
+				continue
+			}
+			report.Name = filepath.Base(file)
+			report.Seen = seen
+			reports = append(reports, report)
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Time.After(reports[j].Time) })
+	return reports, nil
+}
+
+// UnseenCrashReport returns the most recent crash report the user was not
+// told of, or nil if there is none.
+func (wal *Wallet) UnseenCrashReport() *CrashReport {
+	reports, err := wal.CrashReports()
+	if err != nil {
+		log.Errorf("Unable to read the crash reports: %v", err)
+		return nil
+	}
+	for _, report := range reports {
+		if !report.Seen {
+			return report
+		}
+	}
+	return nil
+}
+
+// MarkCrashReportsSeen records that the user was told of the saved crashes.
+func (wal *Wallet) MarkCrashReportsSeen() error {
+	reports, err := wal.CrashReports()
+	if err != nil {
+		return err
+	}
+
+	dir := wal.crashDir()
+	for _, report := range reports {
+		if report.Seen {
+			continue
+		}
+		seen := strings.TrimSuffix(report.Name, crashFileSuffix) + seenCrashFileSuffix
+		if err = os.Rename(filepath.Join(dir, report.Name), filepath.Join(dir, seen)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportCrashReport writes report as text next to the saved report, so the
+// user can attach it to a bug report. The path of the written file is
+// returned.
+func (wal *Wallet) ExportCrashReport(report *CrashReport) (string, error) {
+	name := strings.TrimSuffix(strings.TrimSuffix(report.Name, seenCrashFileSuffix), crashFileSuffix)
+	if name == "" {
+		name = crashFilePrefix + report.Time.Format("20060102-150405.000")
+	}
+
+	dir := wal.crashDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name+".txt")
+	return path, os.WriteFile(path, []byte(report.Text()), 0600)
+}
+
+// pruneCrashReports removes the oldest crash reports over maxCrashReports.
+func (wal *Wallet) pruneCrashReports() {
+	reports, err := wal.CrashReports()
+	if err != nil || len(reports) <= maxCrashReports {
+		return
+	}
+	for _, report := range reports[maxCrashReports:] {
+		if err = os.Remove(filepath.Join(wal.crashDir(), report.Name)); err != nil {
+			log.Errorf("Unable to remove crash report %s: %v", report.Name, err)
+		}
+	}
+}
+
+// Text returns the report in the form it is copied or saved by the user.
+func (report *CrashReport) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "godcr %s crashed at %s\n", report.Version, report.Time.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Build date: %s\n", report.BuildDate.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Go: %s, OS: %s, uptime: %s\n", report.GoVersion, report.OS, report.Uptime)
+	fmt.Fprintf(&b, "Pages: %s\n", strings.Join(report.Pages, " > "))
+
+	keys := make([]string, 0, len(report.Config))
+	for key := range report.Config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	b.WriteString("Config:\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "  %s: %s\n", key, report.Config[key])
+	}
+
+	fmt.Fprintf(&b, "\npanic: %s\n\n%s", report.Panic, report.Stack)
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+func TestCrashReports(t *testing.T) {
+	homeDir := t.TempDir()
+	logFile := filepath.Join(homeDir, "logs", "godcr.log")
+	wal, err := NewWallet(homeDir, dcrlibwallet.Mainnet, "test", logFile, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wal.proxyOverride = &ProxyConfig{Host: "127.0.0.1:9050", Username: "user", Password: "secret"}
+
+	if report := wal.UnseenCrashReport(); report != nil {
+		t.Fatalf("unexpected crash report %+v", report)
+	}
+
+	report := wal.NewCrashReport("index out of range", []byte("goroutine 1 [running]:"), []string{"Main", "Main/Send"})
+	if err = wal.SaveCrashReport(report); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(homeDir, "logs", crashDirName, report.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "9050") || strings.Contains(string(data), "secret") {
+		t.Fatal("crash report contains the proxy config")
+	}
+
+	unseen := wal.UnseenCrashReport()
+	if unseen == nil || unseen.Panic != "index out of range" || len(unseen.Pages) != 2 {
+		t.Fatalf("unseen crash report: got %+v", unseen)
+	}
+	if err = wal.MarkCrashReportsSeen(); err != nil {
+		t.Fatal(err)
+	}
+	if report := wal.UnseenCrashReport(); report != nil {
+		t.Fatalf("crash report not marked seen: %+v", report)
+	}
+	if reports, err := wal.CrashReports(); err != nil || len(reports) != 1 || !reports[0].Seen {
+		t.Fatalf("seen crash reports: got %+v, %v", reports, err)
+	}
+
+	path, err := wal.ExportCrashReport(unseen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text, err := os.ReadFile(path); err != nil || !strings.Contains(string(text), "panic: index out of range") {
+		t.Fatalf("exported crash report: %q, %v", text, err)
+	}
+
+	for i := 0; i < maxCrashReports+2; i++ {
+		report := wal.NewCrashReport(i, nil, nil)
+		report.Time = report.Time.Add(time.Duration(i+1) * time.Second)
+		if err = wal.SaveCrashReport(report); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reports, err := wal.CrashReports()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != maxCrashReports || reports[0].Panic != "11" {
+		t.Fatalf("crash reports after pruning: got %d, newest %q", len(reports), reports[0].Panic)
+	}
+}
@@ -0,0 +1,310 @@
+package wallet
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// diagnosticsDirName is the directory in the app data directory that
+	// diagnostics bundles are written to.
+	diagnosticsDirName = "diagnostics"
+
+	dcrlibwalletModule = "github.com/planetdecred/dcrlibwallet"
+
+	redacted = "<redacted>"
+)
+
+// diagnosticsConfigKeys are the config file options copied to a diagnostics
+// bundle. The values of the other options, such as the proxy address and
+// credentials and the directories, are redacted.
+var diagnosticsConfigKeys = map[string]bool{
+	"network":          true,
+	"debuglevel":       true,
+	"quiet":            true,
+	"spendunconfirmed": true,
+	"max-log-zips":     true,
+	"torisolation":     true,
+	"toronly":          true,
+}
+
+// DiagnosticsValue is a named value shown on the statistics page.
+type DiagnosticsValue struct {
+	Name  string
+	Value string
+
+	// Private is true for values that identify the user, such as paths
+	// that contain the user name. They are redacted in diagnostics bundles.
+	Private bool
+}
+
+// DiagnosticsFile is a file of a diagnostics bundle.
+type DiagnosticsFile struct {
+	// Name is the path of the file in the bundle.
+	Name string
+	Size int64
+
+	// path is the file copied to the bundle. data is the content of a file
+	// made for the bundle.
+	path string
+	data []byte
+}
+
+// DiagnosticsBundle is the set of files the user can attach to a bug report.
+type DiagnosticsBundle struct {
+	Created time.Time
+	Files   []DiagnosticsFile
+}
+
+// SetConfigFile sets the path of the app config file, which is copied
+// redacted to diagnostics bundles.
+func (wal *Wallet) SetConfigFile(path string) {
+	wal.configFile = path
+}
+
+// NewDiagnosticsBundle lists the files of a diagnostics bundle: the current
+// and rotated logs, the system info, stats, the redacted config and the saved
+// crash reports. stats are the values of the statistics page. Nothing is
+// written until SaveDiagnosticsBundle is called, so the files can be shown to
+// the user first.
+func (wal *Wallet) NewDiagnosticsBundle(stats []DiagnosticsValue) (*DiagnosticsBundle, error) {
+	bundle := &DiagnosticsBundle{Created: time.Now()}
+	bundle.addData("system.txt", wal.diagnosticsSystemInfo(bundle.Created))
+
+	var b strings.Builder
+	for _, stat := range stats {
+		value := stat.Value
+		if stat.Private {
+			value = redacted
+		}
+		fmt.Fprintf(&b, "%s: %s\n", stat.Name, value)
+	}
+	bundle.addData("stats.txt", []byte(b.String()))
+
+	cfg, err := wal.diagnosticsConfig()
+	if err != nil {
+		return nil, err
+	}
+	bundle.addData("config.txt", cfg)
+
+	if wal.logFile != "" {
+		logs, err := filepath.Glob(wal.logFile + ".*")
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(logs)
+		for _, path := range append([]string{wal.logFile}, logs...) {
+			if err = bundle.addFile("logs/"+filepath.Base(path), path); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	reports, err := wal.CrashReports()
+	if err != nil {
+		return nil, err
+	}
+	for _, report := range reports {
+		path := filepath.Join(wal.crashDir(), report.Name)
+		if err = bundle.addFile("crashes/"+report.Name, path); err != nil {
+			return nil, err
+		}
+	}
+
+	return bundle, nil
+}
+
+func (bundle *DiagnosticsBundle) addData(name string, data []byte) {
+	bundle.Files = append(bundle.Files, DiagnosticsFile{Name: name, Size: int64(len(data)), data: data})
+}
+
+// addFile adds the file at path. A missing file is left out, the log is not
+// created until something is logged.
+func (bundle *DiagnosticsBundle) addFile(name, path string) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	bundle.Files = append(bundle.Files, DiagnosticsFile{Name: name, Size: info.Size(), path: path})
+	return nil
+}
+
+// SaveDiagnosticsBundle writes bundle as a zip file to the diagnostics
+// directory in the app data directory and returns its path.
+func (wal *Wallet) SaveDiagnosticsBundle(bundle *DiagnosticsBundle) (string, error) {
+	dir := filepath.Join(wal.homeDir, diagnosticsDirName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, "godcr-diagnostics-"+bundle.Created.Format("20060102-150405")+".zip")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	err = bundle.write(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return "", err
+	}
+
+	log.Infof("Diagnostics bundle saved to %s", path)
+	return path, nil
+}
+
+func (bundle *DiagnosticsBundle) write(w io.Writer) error {
+	zw := zip.NewWriter(w)
+	for _, file := range bundle.Files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     file.Name,
+			Method:   zip.Deflate,
+			Modified: bundle.Created,
+		})
+		if err != nil {
+			return err
+		}
+
+		if file.path == "" {
+			_, err = fw.Write(file.data)
+		} else {
+			err = copyFile(fw, file.path)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", file.Name, err)
+		}
+	}
+	return zw.Close()
+}
+
+func copyFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// diagnosticsSystemInfo returns the versions, the platform, the wallet count
+// and the sync state.
+func (wal *Wallet) diagnosticsSystemInfo(now time.Time) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "godcr: %s\n", wal.version)
+	fmt.Fprintf(&b, "Build date: %s\n", wal.buildDate.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "dcrlibwallet: %s\n", dcrlibwalletVersion())
+	fmt.Fprintf(&b, "Go: %s\n", runtime.Version())
+	fmt.Fprintf(&b, "OS: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&b, "Created: %s\n", now.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Uptime: %s\n", now.Sub(wal.startUpTime).Round(time.Second))
+	fmt.Fprintf(&b, "Network: %s\n", wal.Net)
+
+	if wal.multi == nil {
+		b.WriteString("Wallets: not loaded\n")
+		return []byte(b.String())
+	}
+	fmt.Fprintf(&b, "Wallets: %d\n", wal.multi.LoadedWalletsCount())
+	fmt.Fprintf(&b, "Synced: %t\n", wal.multi.IsSynced())
+	fmt.Fprintf(&b, "Syncing: %t\n", wal.multi.IsSyncing())
+	fmt.Fprintf(&b, "Rescanning: %t\n", wal.multi.IsRescanning())
+	if best := wal.multi.GetBestBlock(); best != nil {
+		fmt.Fprintf(&b, "Best block: %d (%s)\n", best.Height, time.Unix(best.Timestamp, 0).UTC().Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, "Connected peers: %d\n", len(wal.ConnectedPeers()))
+	return []byte(b.String())
+}
+
+// dcrlibwalletVersion returns the version of dcrlibwallet the app was built
+// with.
+func dcrlibwalletVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != dcrlibwalletModule {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Path + " " + dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "unknown"
+}
+
+// diagnosticsConfig returns the app settings and the config file with the
+// values that identify the user redacted.
+func (wal *Wallet) diagnosticsConfig() ([]byte, error) {
+	var b bytes.Buffer
+	settings := wal.crashConfig()
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	b.WriteString("# App settings\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%s = %s\n", key, settings[key])
+	}
+
+	if wal.configFile == "" {
+		return b.Bytes(), nil
+	}
+	fmt.Fprintf(&b, "\n# %s\n", filepath.Base(wal.configFile))
+	data, err := os.ReadFile(wal.configFile)
+	if os.IsNotExist(err) {
+		b.WriteString("# not found\n")
+		return b.Bytes(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.Write(redactConfig(data))
+	return b.Bytes(), nil
+}
+
+// redactConfig returns the options and sections of the config file data with
+// the values of the options not in diagnosticsConfigKeys redacted. Comments
+// are left out, they may hold commented out values.
+func redactConfig(data []byte) []byte {
+	var b bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", strings.HasPrefix(line, ";"), strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "["):
+			b.WriteString(line + "\n")
+			continue
+		}
+
+		key, value := line, ""
+		if i := strings.Index(line, "="); i >= 0 {
+			key, value = strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		}
+		if !diagnosticsConfigKeys[strings.ToLower(key)] {
+			value = redacted
+		}
+		fmt.Fprintf(&b, "%s = %s\n", key, value)
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,102 @@
+package wallet
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+func TestDiagnosticsBundle(t *testing.T) {
+	homeDir := t.TempDir()
+	logDir := filepath.Join(homeDir, "logs")
+	logFile := filepath.Join(logDir, "godcr.log")
+	wal, err := NewWallet(homeDir, dcrlibwallet.Mainnet, "test", logFile, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wal.proxyOverride = &ProxyConfig{Host: "127.0.0.1:9050", Username: "user", Password: "secret"}
+
+	if err = os.MkdirAll(logDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		logFile:                       "current log",
+		logFile + ".1.gz":             "rotated log",
+		filepath.Join(homeDir, "cfg"): "[Application Options]\n; proxypass=old\nnetwork=testnet\nproxy=127.0.0.1:9050\nproxypass = secret\n",
+	}
+	for path, data := range files {
+		if err = os.WriteFile(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wal.SetConfigFile(filepath.Join(homeDir, "cfg"))
+
+	report := wal.NewCrashReport("boom", nil, nil)
+	if err = wal.SaveCrashReport(report); err != nil {
+		t.Fatal(err)
+	}
+
+	bundle, err := wal.NewDiagnosticsBundle([]DiagnosticsValue{
+		{Name: "Peers connected", Value: "3"},
+		{Name: "Wallet directory", Value: "/home/alice/.godcr", Private: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"system.txt", "stats.txt", "config.txt", "logs/godcr.log", "logs/godcr.log.1.gz", "crashes/" + report.Name}
+	if len(bundle.Files) != len(want) {
+		t.Fatalf("bundle files: got %+v, want %v", bundle.Files, want)
+	}
+	for i, name := range want {
+		if bundle.Files[i].Name != name {
+			t.Fatalf("bundle file %d: got %s, want %s", i, bundle.Files[i].Name, name)
+		}
+	}
+
+	path, err := wal.SaveDiagnosticsBundle(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+
+	content := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content[f.Name] = string(data)
+	}
+	if len(content) != len(want) {
+		t.Fatalf("zip files: got %d, want %d", len(content), len(want))
+	}
+	if content["logs/godcr.log.1.gz"] != "rotated log" {
+		t.Fatalf("rotated log: got %q", content["logs/godcr.log.1.gz"])
+	}
+	if content["stats.txt"] != "Peers connected: 3\nWallet directory: "+redacted+"\n" {
+		t.Fatalf("stats: got %q", content["stats.txt"])
+	}
+
+	cfg := content["config.txt"]
+	if strings.Contains(cfg, "9050") || strings.Contains(cfg, "secret") || strings.Contains(cfg, "old") {
+		t.Fatalf("config is not redacted:\n%s", cfg)
+	}
+	if !strings.Contains(cfg, "network = testnet") || !strings.Contains(cfg, "proxypass = "+redacted) {
+		t.Fatalf("config:\n%s", cfg)
+	}
+}
@@ -0,0 +1,188 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// IDs of the block explorer settings that are not presets.
+const (
+	// BlockExplorerNone turns off block explorer links so that no lookups
+	// are sent to an explorer.
+	BlockExplorerNone = "none"
+
+	// BlockExplorerCustom uses the URL templates set by the user.
+	BlockExplorerCustom = "custom"
+)
+
+// Placeholders replaced in block explorer URL templates.
+const (
+	ExplorerTxPlaceholder      = "{tx}"
+	ExplorerAddressPlaceholder = "{address}"
+	ExplorerBlockPlaceholder   = "{height}"
+)
+
+const (
+	blockExplorerConfigKey       = "block_explorer"
+	customBlockExplorerConfigKey = "custom_block_explorer"
+)
+
+// ErrNoExplorerURLs is returned when a custom block explorer has no URL
+// templates.
+var ErrNoExplorerURLs = errors.New("at least one explorer URL is required")
+
+// BlockExplorer holds the URL templates of a block explorer. A template is
+// empty if the explorer has no page for that kind of item.
+type BlockExplorer struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	TxURL      string `json:"tx_url"`
+	AddressURL string `json:"address_url"`
+	BlockURL   string `json:"block_url"`
+}
+
+// dcrdataExplorer returns the preset of the dcrdata instance at baseURL.
+func dcrdataExplorer(id, name, baseURL string) BlockExplorer {
+	return BlockExplorer{
+		ID:         id,
+		Name:       name,
+		TxURL:      baseURL + "/tx/" + ExplorerTxPlaceholder,
+		AddressURL: baseURL + "/address/" + ExplorerAddressPlaceholder,
+		BlockURL:   baseURL + "/block/" + ExplorerBlockPlaceholder,
+	}
+}
+
+// Enabled returns true if links to the explorer may be shown.
+func (e BlockExplorer) Enabled() bool {
+	return e.ID != BlockExplorerNone && e.ID != ""
+}
+
+// TxLink returns the URL of the transaction with hash txHash, or "" if the
+// explorer has no transaction pages.
+func (e BlockExplorer) TxLink(txHash string) string {
+	return e.link(e.TxURL, ExplorerTxPlaceholder, txHash)
+}
+
+// AddressLink returns the URL of address, or "" if the explorer has no
+// address pages.
+func (e BlockExplorer) AddressLink(address string) string {
+	return e.link(e.AddressURL, ExplorerAddressPlaceholder, address)
+}
+
+// BlockLink returns the URL of the block at height, or "" if the explorer has
+// no block pages.
+func (e BlockExplorer) BlockLink(height int32) string {
+	return e.link(e.BlockURL, ExplorerBlockPlaceholder, fmt.Sprint(height))
+}
+
+func (e BlockExplorer) link(template, placeholder, value string) string {
+	if !e.Enabled() || template == "" {
+		return ""
+	}
+	return strings.ReplaceAll(template, placeholder, url.PathEscape(value))
+}
+
+// BlockExplorers returns the block explorer presets of the wallet network.
+// The first preset is the default.
+func (wal *Wallet) BlockExplorers() []BlockExplorer {
+	return wal.network().explorers
+}
+
+// BlockExplorer returns the block explorer chosen in the settings, or the
+// default explorer of the network if none was chosen.
+func (wal *Wallet) BlockExplorer() BlockExplorer {
+	explorers := wal.BlockExplorers()
+	id := ""
+	if wal.multi != nil {
+		id = wal.multi.ReadStringConfigValueForKey(blockExplorerConfigKey)
+	}
+
+	switch id {
+	case "":
+		if len(explorers) > 0 {
+			return explorers[0]
+		}
+		return BlockExplorer{ID: BlockExplorerNone}
+	case BlockExplorerNone:
+		return BlockExplorer{ID: BlockExplorerNone}
+	case BlockExplorerCustom:
+		return wal.CustomBlockExplorer()
+	}
+
+	for _, explorer := range explorers {
+		if explorer.ID == id {
+			return explorer
+		}
+	}
+	return BlockExplorer{ID: BlockExplorerNone}
+}
+
+// SetBlockExplorer chooses the block explorer with the given ID. The ID is
+// that of a preset, BlockExplorerNone or BlockExplorerCustom.
+func (wal *Wallet) SetBlockExplorer(id string) error {
+	valid := id == BlockExplorerNone || id == BlockExplorerCustom
+	for _, explorer := range wal.BlockExplorers() {
+		valid = valid || explorer.ID == id
+	}
+	if !valid {
+		return fmt.Errorf("unknown block explorer %q", id)
+	}
+
+	wal.multi.SaveUserConfigValue(blockExplorerConfigKey, id)
+	return nil
+}
+
+// CustomBlockExplorer returns the URL templates set by the user.
+func (wal *Wallet) CustomBlockExplorer() BlockExplorer {
+	var explorer BlockExplorer
+	if wal.multi != nil {
+		wal.multi.ReadUserConfigValue(customBlockExplorerConfigKey, &explorer)
+	}
+	explorer.ID = BlockExplorerCustom
+	return explorer
+}
+
+// SetCustomBlockExplorer saves the URL templates of a custom block explorer
+// and chooses it. Each template that is set must be an http or https URL
+// that contains its placeholder.
+func (wal *Wallet) SetCustomBlockExplorer(explorer BlockExplorer) error {
+	explorer.ID = BlockExplorerCustom
+	explorer.TxURL = strings.TrimSpace(explorer.TxURL)
+	explorer.AddressURL = strings.TrimSpace(explorer.AddressURL)
+	explorer.BlockURL = strings.TrimSpace(explorer.BlockURL)
+
+	if explorer.TxURL == "" && explorer.AddressURL == "" && explorer.BlockURL == "" {
+		return ErrNoExplorerURLs
+	}
+	templates := [][2]string{
+		{explorer.TxURL, ExplorerTxPlaceholder},
+		{explorer.AddressURL, ExplorerAddressPlaceholder},
+		{explorer.BlockURL, ExplorerBlockPlaceholder},
+	}
+	for _, t := range templates {
+		if err := validateExplorerTemplate(t[0], t[1]); err != nil {
+			return err
+		}
+	}
+
+	wal.multi.SaveUserConfigValue(customBlockExplorerConfigKey, explorer)
+	wal.multi.SaveUserConfigValue(blockExplorerConfigKey, BlockExplorerCustom)
+	return nil
+}
+
+func validateExplorerTemplate(template, placeholder string) error {
+	if template == "" {
+		return nil
+	}
+	if !strings.Contains(template, placeholder) {
+		return fmt.Errorf("%s must contain %s", template, placeholder)
+	}
+
+	u, err := url.Parse(strings.ReplaceAll(template, placeholder, "x"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s is not a valid http or https URL", template)
+	}
+	return nil
+}
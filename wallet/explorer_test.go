@@ -0,0 +1,44 @@
+package wallet
+
+import (
+	"testing"
+)
+
+func TestBlockExplorerLinks(t *testing.T) {
+	explorer := dcrdataExplorer("dcrdata", "dcrdata", "https://explorer.dcrdata.org")
+	if link := explorer.TxLink("abc"); link != "https://explorer.dcrdata.org/tx/abc" {
+		t.Errorf("tx link %q", link)
+	}
+	if link := explorer.AddressLink("DsAddr"); link != "https://explorer.dcrdata.org/address/DsAddr" {
+		t.Errorf("address link %q", link)
+	}
+	if link := explorer.BlockLink(42); link != "https://explorer.dcrdata.org/block/42" {
+		t.Errorf("block link %q", link)
+	}
+
+	explorer.ID = BlockExplorerNone
+	if link := explorer.TxLink("abc"); link != "" {
+		t.Errorf("link %q shown with explorer links off", link)
+	}
+}
+
+func TestValidateExplorerTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		valid    bool
+	}{
+		{template: "", valid: true},
+		{template: "http://127.0.0.1:7777/tx/{tx}", valid: true},
+		{template: "https://explorer.example.org/tx?hash={tx}", valid: true},
+		{template: "https://explorer.example.org/tx/", valid: false},
+		{template: "ftp://explorer.example.org/tx/{tx}", valid: false},
+		{template: "explorer.example.org/tx/{tx}", valid: false},
+	}
+
+	for _, test := range tests {
+		err := validateExplorerTemplate(test.template, ExplorerTxPlaceholder)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: got error %v, want valid %v", test.template, err, test.valid)
+		}
+	}
+}
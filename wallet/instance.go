@@ -0,0 +1,395 @@
+package wallet
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// lockFileName is the file in the app data directory that is held by
+	// the running instance of the app.
+	lockFileName = "godcr.lock"
+
+	// heartbeatInterval is how often the running instance updates its
+	// lock file.
+	heartbeatInterval = 10 * time.Second
+
+	// staleLockAge is the age of the heartbeat after which the instance
+	// that holds the lock is checked over its socket.
+	staleLockAge = 3 * heartbeatInterval
+
+	// instanceTimeout is how long a request to the running instance may
+	// take.
+	instanceTimeout = 3 * time.Second
+)
+
+var (
+	// ErrInstanceRunning is returned when the app data directory is used
+	// by an instance of the app that is running.
+	ErrInstanceRunning = errors.New("the app data directory is in use by a running instance of the app")
+
+	// ErrStaleLock is returned when the app data directory is locked by an
+	// instance that no longer runs or responds.
+	ErrStaleLock = errors.New("the app data directory is locked by an instance that does not respond")
+
+	// errLockTakenOver is returned when the lock file was replaced by
+	// another instance, which took it over while this one did not respond.
+	errLockTakenOver = errors.New("the app data lock was taken over by another instance")
+)
+
+// lockInfo is the content of the lock file.
+type lockInfo struct {
+	PID       int       `json:"pid"`
+	Heartbeat time.Time `json:"heartbeat"`
+
+	// Address is the loopback address the instance receives requests on,
+	// and Token authenticates the requests.
+	Address string `json:"address"`
+	Token   string `json:"token"`
+}
+
+// instanceRequest is sent by a later launch of the app to the running
+// instance.
+type instanceRequest struct {
+	Token string   `json:"token"`
+	Ping  bool     `json:"ping,omitempty"`
+	Args  []string `json:"args,omitempty"`
+}
+
+// InstanceLock keeps other instances of the app from opening the app data
+// directory while it is held.
+type InstanceLock struct {
+	path     string
+	info     lockInfo
+	listener net.Listener
+	requests chan []string
+
+	// parent is the lock whose requests channel this lock shares, if any.
+	parent *InstanceLock
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// LockAppData locks the app data directory at homeDir for this instance of
+// the app. ErrInstanceRunning or ErrStaleLock is returned if it is locked.
+func LockAppData(homeDir string) (*InstanceLock, error) {
+	return lockAppData(homeDir, nil)
+}
+
+// LockDir locks the app data directory at dir for this instance as well, as
+// the data directory of a profile is. Requests received for it are passed to
+// the Requests channel of lock. A stale lock of dir is taken over. The
+// returned lock must be released before lock.
+func (lock *InstanceLock) LockDir(dir string) (*InstanceLock, error) {
+	dirLock, err := lockAppData(dir, lock)
+	if errors.Is(err, ErrStaleLock) {
+		return takeOverAppData(dir, lock)
+	}
+	return dirLock, err
+}
+
+// lockAppData locks the app data directory at homeDir. The lock shares the
+// requests channel of parent if it is not nil.
+func lockAppData(homeDir string, parent *InstanceLock) (*InstanceLock, error) {
+	path := filepath.Join(homeDir, lockFileName)
+	if info, err := readLockInfo(path); err == nil {
+		if info.running() {
+			return nil, ErrInstanceRunning
+		}
+		return nil, ErrStaleLock
+	} else if !errors.Is(err, os.ErrNotExist) {
+		// A lock file that cannot be read was left by an instance
+		// that crashed while writing it.
+		return nil, ErrStaleLock
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	token := make([]byte, 16)
+	if _, err = rand.Read(token); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	lock := &InstanceLock{
+		path: path,
+		info: lockInfo{
+			PID:       os.Getpid(),
+			Heartbeat: time.Now(),
+			Address:   listener.Addr().String(),
+			Token:     hex.EncodeToString(token),
+		},
+		listener: listener,
+		parent:   parent,
+		quit:     make(chan struct{}),
+	}
+	if parent != nil {
+		lock.requests = parent.requests
+	} else {
+		lock.requests = make(chan []string, 8)
+	}
+	if err = lock.create(); err != nil {
+		listener.Close()
+		if errors.Is(err, os.ErrExist) {
+			// Another instance took the lock first.
+			return nil, ErrInstanceRunning
+		}
+		return nil, err
+	}
+
+	lock.wg.Add(2)
+	go lock.heartbeat()
+	go lock.serve()
+	return lock, nil
+}
+
+// TakeOverAppData removes a stale lock of the app data directory at homeDir
+// and locks it for this instance.
+func TakeOverAppData(homeDir string) (*InstanceLock, error) {
+	return takeOverAppData(homeDir, nil)
+}
+
+func takeOverAppData(homeDir string, parent *InstanceLock) (*InstanceLock, error) {
+	path := filepath.Join(homeDir, lockFileName)
+	if info, err := readLockInfo(path); err == nil && info.running() {
+		return nil, ErrInstanceRunning
+	}
+
+	log.Warnf("Taking over the stale lock of %s", homeDir)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return lockAppData(homeDir, parent)
+}
+
+// SendToRunningInstance asks the instance that holds the lock of the app data
+// directory at homeDir to come forward and open args.
+func SendToRunningInstance(homeDir string, args []string) error {
+	info, err := readLockInfo(filepath.Join(homeDir, lockFileName))
+	if err != nil {
+		return err
+	}
+	return info.send(instanceRequest{Token: info.Token, Args: args})
+}
+
+// Requests returns the channel that receives the arguments of the later
+// launches of the app. The app window should come forward on each.
+func (lock *InstanceLock) Requests() <-chan []string {
+	return lock.requests
+}
+
+// Release unlocks the app data directory.
+func (lock *InstanceLock) Release() error {
+	close(lock.quit)
+	lock.listener.Close()
+	lock.wg.Wait()
+	if lock.parent == nil {
+		close(lock.requests)
+	}
+
+	// Leave the file alone if it was taken over.
+	info, err := readLockInfo(lock.path)
+	if err != nil || info.Token != lock.info.Token {
+		return nil
+	}
+	return os.Remove(lock.path)
+}
+
+// create writes the lock file, failing with os.ErrExist if it exists. The
+// file is written under another name and linked into place, so other
+// instances never read a partly written lock.
+func (lock *InstanceLock) create() error {
+	tmp, err := lock.writeTemp()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+	err = os.Link(tmp, lock.path)
+	if err == nil || errors.Is(err, os.ErrExist) {
+		return err
+	}
+
+	// The file system may not support hard links, as FAT does not.
+	log.Debugf("Unable to link the app data lock, creating it instead: %v", err)
+	return lock.createExclusive()
+}
+
+// createExclusive writes the lock file in place, failing with os.ErrExist if
+// it exists. Another instance may read it partly written and see a stale
+// lock.
+func (lock *InstanceLock) createExclusive() error {
+	data, err := json.Marshal(lock.info)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(lock.path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(lock.path)
+	}
+	return err
+}
+
+// update rewrites the lock file with the current heartbeat. errLockTakenOver
+// is returned if the lock file is no longer ours, as when this instance hung
+// and another took over its lock, so the lock of the new owner is left alone.
+func (lock *InstanceLock) update() error {
+	info, err := readLockInfo(lock.path)
+	if err != nil || info.Token != lock.info.Token {
+		return errLockTakenOver
+	}
+
+	tmp, err := lock.writeTemp()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, lock.path)
+}
+
+func (lock *InstanceLock) writeTemp() (string, error) {
+	data, err := json.Marshal(lock.info)
+	if err != nil {
+		return "", err
+	}
+	tmp := fmt.Sprintf("%s.%d", lock.path, lock.info.PID)
+	return tmp, os.WriteFile(tmp, data, 0600)
+}
+
+func (lock *InstanceLock) heartbeat() {
+	defer lock.wg.Done()
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-lock.quit:
+			return
+		case <-ticker.C:
+			lock.info.Heartbeat = time.Now()
+			err := lock.update()
+			if errors.Is(err, errLockTakenOver) {
+				log.Errorf("Stopping the heartbeat of %s: %v", lock.path, err)
+				return
+			}
+			if err != nil {
+				log.Errorf("Unable to update the app data lock: %v", err)
+			}
+		}
+	}
+}
+
+func (lock *InstanceLock) serve() {
+	defer lock.wg.Done()
+	for {
+		conn, err := lock.listener.Accept()
+		if err != nil {
+			select {
+			case <-lock.quit:
+			default:
+				log.Errorf("Instance socket error: %v", err)
+			}
+			return
+		}
+		lock.handle(conn)
+	}
+}
+
+func (lock *InstanceLock) handle(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(instanceTimeout))
+
+	var req instanceRequest
+	if err := json.NewDecoder(bufio.NewReader(conn)).Decode(&req); err != nil || req.Token != lock.info.Token {
+		log.Warn("Rejected an invalid request on the instance socket")
+		return
+	}
+	if !req.Ping {
+		select {
+		case lock.requests <- req.Args:
+		default:
+			log.Warn("Dropped a request of another launch of the app")
+		}
+	}
+	fmt.Fprintln(conn, "ok")
+}
+
+func readLockInfo(path string) (*lockInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info := new(lockInfo)
+	return info, json.Unmarshal(data, info)
+}
+
+// running returns true if the instance that wrote info runs. An instance
+// whose heartbeat is old, as after the computer slept, runs if it answers a
+// ping.
+func (info *lockInfo) running() bool {
+	if info.PID == os.Getpid() || !processExists(info.PID) {
+		return false
+	}
+	if time.Since(info.Heartbeat) < staleLockAge {
+		return true
+	}
+	return info.send(instanceRequest{Token: info.Token, Ping: true}) == nil
+}
+
+func (info *lockInfo) send(req instanceRequest) error {
+	conn, err := net.DialTimeout("tcp", info.Address, instanceTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(instanceTimeout))
+
+	if err = json.NewEncoder(conn).Encode(req); err != nil {
+		return err
+	}
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("the running instance did not answer: %v", err)
+	}
+	if reply != "ok\n" {
+		return fmt.Errorf("unexpected answer from the running instance: %q", reply)
+	}
+	return nil
+}
+
+// processExists returns true if a process with pid exists. It may belong to
+// another program if the pid was reused.
+func processExists(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	defer p.Release()
+	if runtime.GOOS == "windows" {
+		// FindProcess fails on windows if the process does not exist.
+		return true
+	}
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
@@ -0,0 +1,152 @@
+package wallet
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestInstanceLock(t *testing.T) {
+	homeDir := t.TempDir()
+	lock, err := LockAppData(homeDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The lock holder looks like another process to the second launch.
+	path := filepath.Join(homeDir, lockFileName)
+	info, err := readLockInfo(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !processExists(info.PID) {
+		t.Fatal("running process not found")
+	}
+
+	args := []string{"decred:DsExampleAddress?amount=1"}
+	if err = SendToRunningInstance(homeDir, args); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-lock.Requests():
+		if len(got) != 1 || got[0] != args[0] {
+			t.Fatalf("request args: got %v, want %v", got, args)
+		}
+	case <-time.After(instanceTimeout):
+		t.Fatal("request not received")
+	}
+
+	bad := *info
+	bad.Token = "wrong"
+	if err = bad.send(instanceRequest{Token: bad.Token}); err == nil {
+		t.Fatal("request with a wrong token accepted")
+	}
+
+	if err = lock.Release(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("lock file not removed: %v", err)
+	}
+}
+
+func TestStaleInstanceLock(t *testing.T) {
+	homeDir := t.TempDir()
+	path := filepath.Join(homeDir, lockFileName)
+	if err := os.WriteFile(path, []byte(`{"pid":1,"heartbeat":"2020-01-01T00:00:00Z","address":"127.0.0.1:1"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LockAppData(homeDir); err != ErrStaleLock {
+		t.Fatalf("lock with an old heartbeat: got %v, want %v", err, ErrStaleLock)
+	}
+
+	lock, err := TakeOverAppData(homeDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+	if _, err = LockAppData(homeDir); err == nil {
+		t.Fatal("app data locked twice")
+	}
+}
+
+func TestCreateExclusiveLock(t *testing.T) {
+	homeDir := t.TempDir()
+	lock := &InstanceLock{
+		path: filepath.Join(homeDir, lockFileName),
+		info: lockInfo{PID: os.Getpid(), Token: "token"},
+	}
+	if err := lock.createExclusive(); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := readLockInfo(lock.path); err != nil || info.Token != "token" {
+		t.Fatalf("lock written in place: got %+v, %v", info, err)
+	}
+	if err := lock.createExclusive(); !errors.Is(err, os.ErrExist) {
+		t.Fatalf("second lock: got %v, want %v", err, os.ErrExist)
+	}
+}
+
+func TestInstanceLockTakenOver(t *testing.T) {
+	homeDir := t.TempDir()
+	lock, err := LockAppData(homeDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+
+	// Another instance took the lock over while this one hung.
+	taken := []byte(`{"pid":1,"token":"new owner"}`)
+	if err = os.WriteFile(lock.path, taken, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = lock.update(); !errors.Is(err, errLockTakenOver) {
+		t.Fatalf("update of a taken over lock: got %v, want %v", err, errLockTakenOver)
+	}
+	if data, err := os.ReadFile(lock.path); err != nil || string(data) != string(taken) {
+		t.Fatalf("lock of the new owner overwritten: %q, %v", data, err)
+	}
+}
+
+func TestLockDir(t *testing.T) {
+	homeDir := t.TempDir()
+	lock, err := LockAppData(homeDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+
+	profileDir := filepath.Join(homeDir, profilesDirName, "test")
+	if err = os.MkdirAll(profileDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	dirLock, err := lock.LockDir(profileDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = LockAppData(profileDir); err == nil {
+		t.Fatal("profile data directory locked twice")
+	}
+
+	// A launch that uses the profile directory as its app data directory
+	// reaches the app through its lock.
+	args := []string{"decred:DsExampleAddress"}
+	if err = SendToRunningInstance(profileDir, args); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-lock.Requests():
+		if len(got) != 1 || got[0] != args[0] {
+			t.Fatalf("request args: got %v, want %v", got, args)
+		}
+	case <-time.After(instanceTimeout):
+		t.Fatal("request not received")
+	}
+
+	if err = dirLock.Release(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -69,6 +69,9 @@ const (
 
 	// ProposalAdded indicates that a new proposal was added
 	ProposalAdded
+
+	// SyncEndedWithError signifies that spv sync stopped because of an error
+	SyncEndedWithError
 )
 
 const (
@@ -95,6 +98,7 @@ type (
 		ConfirmedTxn   TxConfirmed
 		AcctMixerInfo  AccountMixer
 		Proposal       Proposal
+		Err            error
 	}
 
 	RescanUpdate struct {
@@ -0,0 +1,226 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+const (
+	offlineModeConfigKey = "offline_mode"
+
+	// minRequestInterval is the minimum time between two HTTP requests to
+	// the same host.
+	minRequestInterval = time.Second
+)
+
+// NetworkFeature is a part of the app that connects to the network.
+type NetworkFeature int
+
+const (
+	FeatureSync NetworkFeature = iota
+	FeatureGovernance
+	FeatureVSP
+	FeatureDEX
+	FeatureExchangeRate
+	FeatureMixer
+	FeatureTicketBuyer
+)
+
+var (
+	// ErrOffline is returned when a network feature is used in offline
+	// mode.
+	ErrOffline = errors.New("offline mode is on")
+
+	// ErrTimeout is returned when an HTTP request takes too long.
+	ErrTimeout = errors.New("the request timed out")
+
+	// ErrConnection is returned when the server of an HTTP request cannot
+	// be reached.
+	ErrConnection = errors.New("unable to connect to the server")
+
+	// ErrRateLimited is returned when a server refuses an HTTP request
+	// because too many were made.
+	ErrRateLimited = errors.New("too many requests to the server")
+
+	// ErrServerResponse is returned when the response of a server has an
+	// error status or cannot be decoded.
+	ErrServerResponse = errors.New("unexpected server response")
+)
+
+// OfflineMode returns true if every network feature is turned off.
+func (wal *Wallet) OfflineMode() bool {
+	return wal.multi != nil && wal.multi.ReadBoolConfigValueForKey(offlineModeConfigKey, false)
+}
+
+// SetOfflineMode turns offline mode on or off. Turning it on stops the wallet
+// and proposal syncs, the account mixers and the ticket buyers, and logs the
+// DEX client out. Turning it off starts the wallet sync again if the sync
+// policy allows it.
+func (wal *Wallet) SetOfflineMode(offline bool) {
+	wal.multi.SaveUserConfigValue(offlineModeConfigKey, offline)
+	if !offline {
+		if !wal.multi.IsConnectedToDecredNetwork() {
+			go func() {
+				if err := wal.SpvSync(); err != nil {
+					log.Infof("Sync not started after leaving offline mode: %v", err)
+				}
+			}()
+		}
+		return
+	}
+
+	go wal.stopNetworkFeatures()
+}
+
+// stopNetworkFeatures stops everything that connects to the network.
+func (wal *Wallet) stopNetworkFeatures() {
+	wal.multi.CancelSync()
+	if wal.multi.Politeia.IsSyncing() {
+		wal.multi.Politeia.StopSync()
+	}
+
+	for _, w := range wal.multi.AllWallets() {
+		if w.IsAccountMixerActive() {
+			if err := wal.multi.StopAccountMixer(w.ID); err != nil {
+				log.Errorf("Unable to stop the account mixer of wallet %d: %v", w.ID, err)
+			}
+		}
+		if w.IsAutoTicketsPurchaseActive() {
+			if err := wal.multi.StopAutoTicketsPurchase(w.ID); err != nil {
+				log.Errorf("Unable to stop the ticket buyer of wallet %d: %v", w.ID, err)
+			}
+		}
+	}
+
+	wal.logoutDexClient()
+}
+
+// logoutDexClient logs the DEX client out, which locks its DEX accounts and
+// wallets so no order can be placed. dcrlibwallet only stops the client when
+// the wallets shut down, so its server connections stay open until then.
+func (wal *Wallet) logoutDexClient() {
+	dc := wal.multi.DexClient()
+	if dc == nil || dc.Core() == nil || !dc.IsLoggedIn() {
+		return
+	}
+	if err := dc.Core().Logout(); err != nil {
+		log.Errorf("Unable to log the DEX client out: %v", err)
+		return
+	}
+	atomic.StoreUint32(&wal.dexLoggedOut, 1)
+}
+
+// DexLoginRequired returns true if dc must log in before it is used.
+func (wal *Wallet) DexLoginRequired(dc *dcrlibwallet.DexClient) bool {
+	return !dc.IsLoggedIn() || atomic.LoadUint32(&wal.dexLoggedOut) == 1
+}
+
+// DexLogin logs dc in with pass.
+func (wal *Wallet) DexLogin(dc *dcrlibwallet.DexClient, pass []byte) error {
+	if err := dc.Login(pass); err != nil {
+		return err
+	}
+	atomic.StoreUint32(&wal.dexLoggedOut, 0)
+	return nil
+}
+
+// FeatureError returns the reason feature cannot be used, or nil if it can.
+func (wal *Wallet) FeatureError(feature NetworkFeature) error {
+	if wal.OfflineMode() {
+		return ErrOffline
+	}
+
+	// Exchange rates are fetched by the app and can go through the proxy.
+	if feature != FeatureExchangeRate && !wal.DirectConnectionsAllowed() {
+		return ErrDirectConnection
+	}
+
+	if feature == FeatureSync {
+		return wal.SyncPolicy().err(time.Now())
+	}
+	return nil
+}
+
+// HTTPGet decodes the JSON response of a GET request to url into target. The
+// request goes through the proxy, sends the user agent set in the settings
+// and waits if the previous request to the same host was too recent. Errors
+// wrap ErrOffline, ErrTimeout, ErrConnection, ErrRateLimited or
+// ErrServerResponse.
+func (wal *Wallet) HTTPGet(ctx context.Context, url string, target interface{}) error {
+	if wal.OfflineMode() {
+		return ErrOffline
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if wal.multi != nil {
+		if userAgent := wal.multi.ReadStringConfigValueForKey(dcrlibwallet.UserAgentConfigKey); userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+	}
+
+	if err = wal.waitForHost(ctx, req.URL.Host); err != nil {
+		return classifyHTTPError(err)
+	}
+
+	resp, err := wal.httpClient().Do(req)
+	if err != nil {
+		return classifyHTTPError(err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return fmt.Errorf("%w: %s", ErrServerResponse, resp.Status)
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("%w: %v", ErrServerResponse, err)
+	}
+	return nil
+}
+
+// waitForHost blocks until a request to host is allowed by the rate limit.
+func (wal *Wallet) waitForHost(ctx context.Context, host string) error {
+	wal.httpMu.Lock()
+	if wal.nextRequest == nil {
+		wal.nextRequest = make(map[string]time.Time)
+	}
+	now := time.Now()
+	next := wal.nextRequest[host]
+	if next.Before(now) {
+		next = now
+	}
+	wal.nextRequest[host] = next.Add(minRequestInterval)
+	wal.httpMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(next)):
+		return nil
+	}
+}
+
+func classifyHTTPError(err error) error {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return fmt.Errorf("%w: %v", ErrTimeout, err)
+	case errors.Is(err, context.Canceled):
+		return err
+	}
+	return fmt.Errorf("%w: %v", ErrConnection, err)
+}
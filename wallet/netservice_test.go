@@ -0,0 +1,57 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForHost(t *testing.T) {
+	wal := &Wallet{}
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := wal.waitForHost(ctx, "example.org"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < minRequestInterval {
+		t.Fatalf("second request to the same host after %v", elapsed)
+	}
+
+	start = time.Now()
+	if err := wal.waitForHost(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed >= minRequestInterval {
+		t.Fatalf("request to another host waited %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := wal.waitForHost(ctx, "example.org"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestClassifyHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Timeout: 10 * time.Millisecond}
+	_, err := client.Get(server.URL)
+	if err = classifyHTTPError(err); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("got %v, want %v", err, ErrTimeout)
+	}
+
+	_, err = http.Get("http://127.0.0.1:1")
+	if err = classifyHTTPError(err); !errors.Is(err, ErrConnection) {
+		t.Fatalf("got %v, want %v", err, ErrConnection)
+	}
+}
@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+// networkParams holds the values that differ between the networks the app
+// can run on.
+type networkParams struct {
+	hdPrefix string
+
+	// explorers are the block explorer presets of the network. The first
+	// is the default. There are none when the network has no public block
+	// explorer.
+	explorers []BlockExplorer
+
+	// politeiaHost is empty when the network has no politeia instance.
+	politeiaHost string
+
+	// peerPort is the port of peer addresses that have none.
+	peerPort string
+}
+
+var networks = map[string]networkParams{
+	dcrlibwallet.Mainnet: {
+		hdPrefix: dcrlibwallet.MainnetHDPath,
+		explorers: []BlockExplorer{
+			dcrdataExplorer("dcrdata", "dcrdata", "https://explorer.dcrdata.org"),
+			dcrdataExplorer("dcrdata-decred-org", "dcrdata (decred.org)", "https://dcrdata.decred.org"),
+		},
+		politeiaHost: dcrlibwallet.PoliteiaMainnetHost,
+		peerPort:     "9108",
+	},
+	dcrlibwallet.Testnet3: {
+		hdPrefix: dcrlibwallet.TestnetHDPath,
+		explorers: []BlockExplorer{
+			dcrdataExplorer("dcrdata-testnet", "dcrdata testnet", "https://testnet.dcrdata.org"),
+		},
+		politeiaHost: dcrlibwallet.PoliteiaTestnetHost,
+		peerPort:     "19108",
+	},
+}
+
+func (wal *Wallet) network() networkParams {
+	return networks[wal.Net]
+}
+
+// HDPrefix returns the HD derivation path of accounts on the wallet network,
+// without the account number.
+func (wal *Wallet) HDPrefix() string {
+	return wal.network().hdPrefix
+}
@@ -0,0 +1,178 @@
+package wallet
+
+import (
+	"time"
+)
+
+// Passphrase scopes track failed attempts of different passphrases
+// separately.
+const (
+	PassphraseScopeStartup  = "startup"
+	PassphraseScopeSpending = "spending"
+)
+
+// Security audit events.
+const (
+	AuditEventPassphraseFailed   = "passphrase_failed"
+	AuditEventPassphraseAccepted = "passphrase_accepted"
+	AuditEventSeedRequired       = "seed_required"
+	AuditEventSeedVerified       = "seed_verified"
+)
+
+const (
+	passphraseAttemptsConfigKey = "passphrase_attempts"
+	securityAuditLogConfigKey   = "security_audit_log"
+
+	// freePassphraseAttempts is the number of failed attempts allowed before
+	// a delay is enforced.
+	freePassphraseAttempts = 3
+
+	// minPassphraseDelay is the delay after the first failure beyond the
+	// free attempts. It doubles with every further failure up to
+	// maxPassphraseDelay.
+	minPassphraseDelay = 5 * time.Second
+	maxPassphraseDelay = time.Hour
+
+	// SeedRequiredPassphraseFailures is the number of consecutive failed
+	// spending passphrase attempts after which the wallet seed is required,
+	// if enabled by the user.
+	SeedRequiredPassphraseFailures = 10
+
+	maxSecurityAuditEvents = 500
+)
+
+// passphraseAttempts is the persisted record of consecutive failed attempts
+// of a passphrase scope.
+type passphraseAttempts struct {
+	Failures    int       `json:"failures"`
+	LastFailure time.Time `json:"lastFailure"`
+}
+
+// SecurityAuditEvent is an entry of the security audit log.
+type SecurityAuditEvent struct {
+	Time     time.Time `json:"time"`
+	Scope    string    `json:"scope"`
+	Event    string    `json:"event"`
+	Failures int       `json:"failures,omitempty"`
+}
+
+// passphraseDelay returns the delay that must pass after the last of failures
+// consecutive failed attempts before the passphrase may be entered again.
+func passphraseDelay(failures int) time.Duration {
+	if failures < freePassphraseAttempts {
+		return 0
+	}
+
+	delay := minPassphraseDelay
+	for i := freePassphraseAttempts; i < failures && delay < maxPassphraseDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxPassphraseDelay {
+		delay = maxPassphraseDelay
+	}
+	return delay
+}
+
+func (wal *Wallet) readPassphraseAttempts() map[string]passphraseAttempts {
+	attempts := make(map[string]passphraseAttempts)
+	wal.multi.ReadUserConfigValue(passphraseAttemptsConfigKey, &attempts)
+	return attempts
+}
+
+// PassphraseRetryDelay returns how long to wait before the passphrase of scope
+// may be entered again. It is 0 if a new attempt is allowed.
+func (wal *Wallet) PassphraseRetryDelay(scope string) time.Duration {
+	wal.securityMu.Lock()
+	defer wal.securityMu.Unlock()
+
+	attempts := wal.readPassphraseAttempts()[scope]
+	remaining := time.Until(attempts.LastFailure.Add(passphraseDelay(attempts.Failures)))
+	if remaining < 0 {
+		return 0
+	}
+	return remaining.Round(time.Second)
+}
+
+// PassphraseFailures returns the number of consecutive failed attempts of the
+// passphrase of scope.
+func (wal *Wallet) PassphraseFailures(scope string) int {
+	wal.securityMu.Lock()
+	defer wal.securityMu.Unlock()
+
+	return wal.readPassphraseAttempts()[scope].Failures
+}
+
+// RecordPassphraseFailure records a failed attempt of the passphrase of scope
+// and returns the delay before the next attempt is allowed.
+func (wal *Wallet) RecordPassphraseFailure(scope string) time.Duration {
+	wal.securityMu.Lock()
+	defer wal.securityMu.Unlock()
+
+	attempts := wal.readPassphraseAttempts()
+	scopeAttempts := attempts[scope]
+	scopeAttempts.Failures++
+	scopeAttempts.LastFailure = time.Now()
+	attempts[scope] = scopeAttempts
+	wal.multi.SaveUserConfigValue(passphraseAttemptsConfigKey, attempts)
+
+	log.Warnf("Failed %s passphrase attempt (%d consecutive failures)", scope, scopeAttempts.Failures)
+	wal.addSecurityAuditEvent(scope, AuditEventPassphraseFailed, scopeAttempts.Failures)
+	return passphraseDelay(scopeAttempts.Failures)
+}
+
+// ResetPassphraseFailures clears the failed attempts of the passphrase of scope
+// after the passphrase or the wallet seed was entered correctly.
+func (wal *Wallet) ResetPassphraseFailures(scope, event string) {
+	wal.securityMu.Lock()
+	defer wal.securityMu.Unlock()
+
+	attempts := wal.readPassphraseAttempts()
+	failures := attempts[scope].Failures
+	if failures == 0 {
+		return
+	}
+
+	delete(attempts, scope)
+	wal.multi.SaveUserConfigValue(passphraseAttemptsConfigKey, attempts)
+	wal.addSecurityAuditEvent(scope, event, failures)
+}
+
+// AddSecurityAuditEvent adds an event to the security audit log.
+func (wal *Wallet) AddSecurityAuditEvent(scope, event string) {
+	wal.securityMu.Lock()
+	defer wal.securityMu.Unlock()
+
+	wal.addSecurityAuditEvent(scope, event, wal.readPassphraseAttempts()[scope].Failures)
+}
+
+func (wal *Wallet) addSecurityAuditEvent(scope, event string, failures int) {
+	events := wal.securityAuditLog()
+	events = append(events, SecurityAuditEvent{
+		Time:     time.Now(),
+		Scope:    scope,
+		Event:    event,
+		Failures: failures,
+	})
+	if len(events) > maxSecurityAuditEvents {
+		events = events[len(events)-maxSecurityAuditEvents:]
+	}
+	wal.multi.SaveUserConfigValue(securityAuditLogConfigKey, events)
+}
+
+func (wal *Wallet) securityAuditLog() []SecurityAuditEvent {
+	var events []SecurityAuditEvent
+	wal.multi.ReadUserConfigValue(securityAuditLogConfigKey, &events)
+	return events
+}
+
+// SecurityAuditLog returns the security audit log, most recent event first.
+func (wal *Wallet) SecurityAuditLog() []SecurityAuditEvent {
+	wal.securityMu.Lock()
+	defer wal.securityMu.Unlock()
+
+	events := wal.securityAuditLog()
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events
+}
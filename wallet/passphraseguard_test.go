@@ -0,0 +1,26 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPassphraseDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{0, 0},
+		{freePassphraseAttempts - 1, 0},
+		{freePassphraseAttempts, minPassphraseDelay},
+		{freePassphraseAttempts + 1, 2 * minPassphraseDelay},
+		{freePassphraseAttempts + 3, 8 * minPassphraseDelay},
+		{1000, maxPassphraseDelay},
+	}
+
+	for _, test := range tests {
+		if delay := passphraseDelay(test.failures); delay != test.delay {
+			t.Errorf("passphraseDelay(%d) = %v, want %v", test.failures, delay, test.delay)
+		}
+	}
+}
@@ -0,0 +1,928 @@
+package wallet
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// MaxPasswordScore is the score of very unguessable passwords. Password scores
+// range from 0, too guessable, to MaxPasswordScore.
+const MaxPasswordScore = 4
+
+// PasswordWarning explains what makes a password easy to guess.
+type PasswordWarning int
+
+const (
+	PasswordWarningNone PasswordWarning = iota
+	PasswordWarningCommon
+	PasswordWarningSimilarToCommon
+	PasswordWarningWordByItself
+	PasswordWarningUserInput
+	PasswordWarningStraightRow
+	PasswordWarningShortKeyboardPattern
+	PasswordWarningRepeat
+	PasswordWarningSequence
+	PasswordWarningDate
+	PasswordWarningRecentYear
+)
+
+// PasswordSuggestion is advice for choosing a stronger password.
+type PasswordSuggestion int
+
+const (
+	PasswordSuggestionAddWords PasswordSuggestion = iota
+	PasswordSuggestionAvoidCapitalization
+	PasswordSuggestionAvoidReversed
+	PasswordSuggestionAvoidSubstitutions
+	PasswordSuggestionLongerKeyboardPattern
+	PasswordSuggestionAvoidRepeats
+	PasswordSuggestionAvoidSequences
+	PasswordSuggestionAvoidDates
+)
+
+// PasswordStrength is an estimate of how hard a password is to guess.
+type PasswordStrength struct {
+	Score   int
+	Guesses float64
+	// CrackTime is the estimated number of seconds needed to guess the
+	// password offline, see passwordGuessesPerSecond.
+	CrackTime   float64
+	Warning     PasswordWarning
+	Suggestions []PasswordSuggestion
+}
+
+const (
+	// passwordStrengthMaxLength is the number of password bytes that are
+	// searched for patterns. Longer passwords are only stronger.
+	passwordStrengthMaxLength = 100
+
+	// passwordGuessesPerSecond is the rate of an offline attack against a
+	// slow hash such as the one protecting wallet keys.
+	passwordGuessesPerSecond = 1e4
+
+	minDictionaryWordLength = 3
+
+	// minGuessesBeforeGrowingSequence penalizes splitting a password into
+	// many short patterns rather than guessing it with fewer long ones.
+	minGuessesBeforeGrowingSequence = 1e4
+	minSubmatchGuessesSingleChar    = 10
+	minSubmatchGuessesMultiChar     = 50
+
+	maxSequenceDelta = 5
+	minYearSpace     = 20
+)
+
+type passwordPattern int
+
+const (
+	patternBruteforce passwordPattern = iota
+	patternDictionary
+	patternSpatial
+	patternRepeat
+	patternSequence
+	patternDate
+	patternYear
+)
+
+type passwordDictionary int
+
+const (
+	dictionaryPasswords passwordDictionary = iota
+	dictionaryWords
+	dictionaryUserInputs
+)
+
+// passwordMatch is a pattern found between the inclusive byte positions i and
+// j of a password.
+type passwordMatch struct {
+	pattern passwordPattern
+	i, j    int
+	guesses float64
+
+	dictionary  passwordDictionary
+	capitalized bool
+	reversed    bool
+	l33t        bool
+	turns       int
+}
+
+var rankedDictionaries = map[passwordDictionary]map[string]int{
+	dictionaryPasswords: rankedWords(strings.Fields(commonPasswords)),
+	dictionaryWords:     rankedWords(strings.Fields(englishWords)),
+}
+
+// l33tSubstitutions maps the characters commonly substituted for letters to
+// the letters they replace.
+var l33tSubstitutions = map[byte]byte{
+	'4': 'a', '@': 'a', '8': 'b', '(': 'c', '3': 'e', '6': 'g', '9': 'g',
+	'1': 'i', '!': 'i', '|': 'i', '0': 'o', '$': 's', '5': 's', '7': 't',
+	'+': 't', '2': 'z',
+}
+
+// EstimatePasswordStrength estimates how many guesses are needed to find
+// password by searching it for common passwords, dictionary words, keyboard
+// patterns, repeats, sequences and dates. userInputs are words an attacker may
+// know, such as the wallet name.
+func EstimatePasswordStrength(password []byte, userInputs ...string) PasswordStrength {
+	if len(password) == 0 {
+		return PasswordStrength{}
+	}
+	if len(password) > passwordStrengthMaxLength {
+		password = password[:passwordStrengthMaxLength]
+	}
+
+	sequence, guesses := mostGuessableMatches(password, userInputDictionary(userInputs))
+	strength := PasswordStrength{
+		Score:     passwordScore(guesses),
+		Guesses:   guesses,
+		CrackTime: guesses / passwordGuessesPerSecond,
+	}
+	strength.Warning, strength.Suggestions = passwordFeedback(strength.Score, sequence)
+	return strength
+}
+
+func rankedWords(words []string) map[string]int {
+	ranked := make(map[string]int, len(words))
+	for i, word := range words {
+		if _, ok := ranked[word]; !ok {
+			ranked[word] = i + 1
+		}
+	}
+	return ranked
+}
+
+func userInputDictionary(inputs []string) map[string]int {
+	var words []string
+	for _, input := range inputs {
+		input = strings.ToLower(input)
+		words = append(words, input, strings.Join(strings.Fields(input), ""))
+		words = append(words, strings.FieldsFunc(input, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})...)
+	}
+	return rankedWords(words)
+}
+
+func passwordScore(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	}
+	return MaxPasswordScore
+}
+
+// mostGuessableMatches returns the sequence of matches that covers password
+// with the fewest guesses and that number of guesses.
+func mostGuessableMatches(password []byte, userDictionary map[string]int) ([]passwordMatch, float64) {
+	lower := bytes.ToLower(password)
+	defer ZeroBytes(lower)
+
+	var matches []passwordMatch
+	matches = append(matches, dictionaryMatches(password, lower, userDictionary)...)
+	matches = append(matches, reversedDictionaryMatches(password, lower, userDictionary)...)
+	matches = append(matches, l33tMatches(password, lower, userDictionary)...)
+	matches = append(matches, spatialMatches(password)...)
+	matches = append(matches, repeatMatches(password, userDictionary)...)
+	matches = append(matches, sequenceMatches(password)...)
+	matches = append(matches, dateMatches(password)...)
+	return mostGuessableSequence(password, matches)
+}
+
+// mostGuessableSequence finds the sequence of non-overlapping matches, with
+// bruteforce filling the gaps, that needs the fewest guesses. A sequence of l
+// matches needs l! times the product of the guesses of its matches.
+func mostGuessableSequence(password []byte, matches []passwordMatch) ([]passwordMatch, float64) {
+	n := len(password)
+	byEnd := make([][]passwordMatch, n)
+	for _, m := range matches {
+		m.guesses = submatchGuesses(m, n)
+		byEnd[m.j] = append(byEnd[m.j], m)
+	}
+
+	type step struct {
+		match passwordMatch
+		pi, g float64
+		ok    bool
+	}
+	// optimal[k][l] is the best sequence of l matches covering password[:k+1].
+	optimal := make([][]step, n)
+	for k := range optimal {
+		optimal[k] = make([]step, n+2)
+	}
+
+	update := func(m passwordMatch, l int) {
+		pi := m.guesses
+		if l > 1 {
+			pi *= optimal[m.i-1][l-1].pi
+		}
+		g := factorial(l)*pi + math.Pow(minGuessesBeforeGrowingSequence, float64(l-1))
+		for competingL, competing := range optimal[m.j][:l+1] {
+			if competingL > 0 && competing.ok && competing.g <= g {
+				return
+			}
+		}
+		optimal[m.j][l] = step{match: m, pi: pi, g: g, ok: true}
+	}
+
+	bruteforce := func(i, j int) passwordMatch {
+		m := passwordMatch{pattern: patternBruteforce, i: i, j: j, guesses: bruteforceGuesses(j - i + 1)}
+		m.guesses = submatchGuesses(m, n)
+		return m
+	}
+
+	for k := 0; k < n; k++ {
+		for _, m := range byEnd[k] {
+			if m.i == 0 {
+				update(m, 1)
+				continue
+			}
+			for l, s := range optimal[m.i-1] {
+				if s.ok {
+					update(m, l+1)
+				}
+			}
+		}
+
+		update(bruteforce(0, k), 1)
+		for i := 1; i <= k; i++ {
+			for l, s := range optimal[i-1] {
+				// Adjacent bruteforce matches are always worse than one.
+				if s.ok && s.match.pattern != patternBruteforce {
+					update(bruteforce(i, k), l+1)
+				}
+			}
+		}
+	}
+
+	bestL, guesses := 0, math.Inf(1)
+	for l, s := range optimal[n-1] {
+		if s.ok && s.g < guesses {
+			bestL, guesses = l, s.g
+		}
+	}
+
+	sequence := make([]passwordMatch, bestL)
+	for k, l := n-1, bestL; l > 0; l-- {
+		sequence[l-1] = optimal[k][l].match
+		k = optimal[k][l].match.i - 1
+	}
+	return sequence, guesses
+}
+
+// submatchGuesses raises the guesses of a match that is only part of the
+// password, as short patterns are rarely guessed on their own.
+func submatchGuesses(m passwordMatch, passwordLength int) float64 {
+	length := m.j - m.i + 1
+	if length >= passwordLength {
+		return m.guesses
+	}
+	if length == 1 {
+		return math.Max(m.guesses, minSubmatchGuessesSingleChar)
+	}
+	return math.Max(m.guesses, minSubmatchGuessesMultiChar)
+}
+
+func dictionaryMatches(password, token []byte, userDictionary map[string]int) []passwordMatch {
+	var matches []passwordMatch
+	lookup := func(dictionary passwordDictionary, words map[string]int) {
+		for i := range token {
+			for j := i + minDictionaryWordLength - 1; j < len(token); j++ {
+				rank, ok := words[string(token[i:j+1])]
+				if !ok {
+					continue
+				}
+				variations := uppercaseVariations(password[i : j+1])
+				matches = append(matches, passwordMatch{
+					pattern:     patternDictionary,
+					i:           i,
+					j:           j,
+					guesses:     float64(rank) * variations,
+					dictionary:  dictionary,
+					capitalized: variations > 1,
+				})
+			}
+		}
+	}
+
+	lookup(dictionaryPasswords, rankedDictionaries[dictionaryPasswords])
+	lookup(dictionaryWords, rankedDictionaries[dictionaryWords])
+	lookup(dictionaryUserInputs, userDictionary)
+	return matches
+}
+
+func reversedDictionaryMatches(password, lower []byte, userDictionary map[string]int) []passwordMatch {
+	reversedPassword, reversedLower := reversedBytes(password), reversedBytes(lower)
+	defer ZeroBytes(reversedPassword)
+	defer ZeroBytes(reversedLower)
+
+	matches := dictionaryMatches(reversedPassword, reversedLower, userDictionary)
+	for k := range matches {
+		m := &matches[k]
+		m.i, m.j = len(password)-1-m.j, len(password)-1-m.i
+		m.reversed = true
+		m.guesses *= 2
+	}
+	return matches
+}
+
+func l33tMatches(password, lower []byte, userDictionary map[string]int) []passwordMatch {
+	substituted := make([]byte, len(lower))
+	defer ZeroBytes(substituted)
+	var hasSubstitutions bool
+	for i, c := range lower {
+		substituted[i] = c
+		if letter, ok := l33tSubstitutions[c]; ok {
+			substituted[i] = letter
+			hasSubstitutions = true
+		}
+	}
+	if !hasSubstitutions {
+		return nil
+	}
+
+	var matches []passwordMatch
+	for _, m := range dictionaryMatches(password, substituted, userDictionary) {
+		if bytes.Equal(lower[m.i:m.j+1], substituted[m.i:m.j+1]) {
+			continue
+		}
+		m.l33t = true
+		m.guesses *= l33tVariations(lower[m.i : m.j+1])
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// uppercaseVariations returns the number of ways token could be capitalized
+// that are as likely as the way it is.
+func uppercaseVariations(token []byte) float64 {
+	var upper, lower int
+	for _, c := range token {
+		switch {
+		case 'A' <= c && c <= 'Z':
+			upper++
+		case 'a' <= c && c <= 'z':
+			lower++
+		}
+	}
+
+	if upper == 0 {
+		return 1
+	}
+	isUpper := func(c byte) bool { return 'A' <= c && c <= 'Z' }
+	if lower == 0 || upper == 1 && (isUpper(token[0]) || isUpper(token[len(token)-1])) {
+		return 2
+	}
+
+	var variations float64
+	for i := 1; i <= upper && i <= lower; i++ {
+		variations += binomial(upper+lower, i)
+	}
+	return variations
+}
+
+// l33tVariations returns the number of ways the substitutions in token could
+// have been made.
+func l33tVariations(token []byte) float64 {
+	variations := 1.0
+	for substitute, letter := range l33tSubstitutions {
+		substituted, unsubstituted := bytes.Count(token, []byte{substitute}), bytes.Count(token, []byte{letter})
+		switch {
+		case substituted == 0:
+			continue
+		case unsubstituted == 0:
+			variations *= 2
+		default:
+			var possibilities float64
+			for i := 1; i <= substituted && i <= unsubstituted; i++ {
+				possibilities += binomial(substituted+unsubstituted, i)
+			}
+			variations *= possibilities
+		}
+	}
+	return variations
+}
+
+// keyPosition is the position of a key on a qwerty keyboard. Every row is
+// offset half a key to the right of the row above it.
+type keyPosition struct {
+	row, col int
+	shifted  bool
+}
+
+var keyboardRows = [][2]string{
+	{"1234567890-=", "!@#$%^&*()_+"},
+	{"qwertyuiop[]\\", "QWERTYUIOP{}|"},
+	{"asdfghjkl;'", "ASDFGHJKL:\""},
+	{"zxcvbnm,./", "ZXCVBNM<>?"},
+}
+
+var keyboardPositions = func() map[byte]keyPosition {
+	positions := make(map[byte]keyPosition)
+	for row, keys := range keyboardRows {
+		for col := range keys[0] {
+			positions[keys[0][col]] = keyPosition{row: row, col: col}
+			positions[keys[1][col]] = keyPosition{row: row, col: col, shifted: true}
+		}
+	}
+	return positions
+}()
+
+// keyboardAverageDegree is the average number of keys next to a key.
+var keyboardAverageDegree = func() float64 {
+	var keys, neighbours int
+	for row, rowKeys := range keyboardRows {
+		for col := range rowKeys[0] {
+			keys++
+			for direction := 1; direction <= 6; direction++ {
+				r, c := keyNeighbour(row, col, direction)
+				if r >= 0 && r < len(keyboardRows) && c >= 0 && c < len(keyboardRows[r][0]) {
+					neighbours++
+				}
+			}
+		}
+	}
+	return float64(neighbours) / float64(keys)
+}()
+
+// keyNeighbour returns the row and column of the key next to the key at row
+// and col in direction, one of left, right, up left, up right, down left and
+// down right.
+func keyNeighbour(row, col, direction int) (int, int) {
+	switch direction {
+	case 1:
+		return row, col - 1
+	case 2:
+		return row, col + 1
+	case 3:
+		return row - 1, col
+	case 4:
+		return row - 1, col + 1
+	case 5:
+		return row + 1, col - 1
+	default:
+		return row + 1, col
+	}
+}
+
+// keyDirection returns the direction from key a to key b, or 0 if they are not
+// next to each other.
+func keyDirection(a, b byte) int {
+	pa, ok := keyboardPositions[a]
+	if !ok {
+		return 0
+	}
+	pb, ok := keyboardPositions[b]
+	if !ok {
+		return 0
+	}
+
+	for direction := 1; direction <= 6; direction++ {
+		if row, col := keyNeighbour(pa.row, pa.col, direction); row == pb.row && col == pb.col {
+			return direction
+		}
+	}
+	return 0
+}
+
+func spatialMatches(password []byte) []passwordMatch {
+	var matches []passwordMatch
+	for i := 0; i < len(password)-2; {
+		j, turns, lastDirection := i, 0, 0
+		for j+1 < len(password) {
+			direction := keyDirection(password[j], password[j+1])
+			if direction == 0 {
+				break
+			}
+			if direction != lastDirection {
+				turns++
+				lastDirection = direction
+			}
+			j++
+		}
+
+		if j-i >= 2 {
+			var shifted int
+			for _, c := range password[i : j+1] {
+				if keyboardPositions[c].shifted {
+					shifted++
+				}
+			}
+			matches = append(matches, passwordMatch{
+				pattern: patternSpatial,
+				i:       i,
+				j:       j,
+				guesses: spatialGuesses(j-i+1, turns, shifted),
+				turns:   turns,
+			})
+		}
+
+		if j > i {
+			i = j
+		} else {
+			i++
+		}
+	}
+	return matches
+}
+
+func spatialGuesses(length, turns, shifted int) float64 {
+	startingPositions := float64(len(keyboardPositions))
+	var guesses float64
+	for i := 2; i <= length; i++ {
+		for j := 1; j <= turns && j <= i-1; j++ {
+			guesses += binomial(i-1, j-1) * startingPositions * math.Pow(keyboardAverageDegree, float64(j))
+		}
+	}
+
+	unshifted := length - shifted
+	switch {
+	case shifted == 0:
+	case unshifted == 0:
+		guesses *= 2
+	default:
+		var variations float64
+		for i := 1; i <= shifted && i <= unshifted; i++ {
+			variations += binomial(length, i)
+		}
+		guesses *= variations
+	}
+	return guesses
+}
+
+// repeatMatches finds runs of a repeated base such as "aaa" or "abcabc". The
+// guesses of a run are those of its base times the number of repeats.
+func repeatMatches(password []byte, userDictionary map[string]int) []passwordMatch {
+	var matches []passwordMatch
+	for i := 0; i < len(password)-1; {
+		bestLength, bestBase := 0, 0
+		for base := 1; i+2*base <= len(password); base++ {
+			count := 1
+			for i+(count+1)*base <= len(password) &&
+				bytes.Equal(password[i:i+base], password[i+count*base:i+(count+1)*base]) {
+				count++
+			}
+			if count >= 2 && count*base > bestLength {
+				bestLength, bestBase = count*base, base
+			}
+		}
+
+		if bestLength == 0 {
+			i++
+			continue
+		}
+
+		_, baseGuesses := mostGuessableMatches(password[i:i+bestBase], userDictionary)
+		matches = append(matches, passwordMatch{
+			pattern: patternRepeat,
+			i:       i,
+			j:       i + bestLength - 1,
+			guesses: baseGuesses * float64(bestLength/bestBase),
+		})
+		i += bestLength
+	}
+	return matches
+}
+
+// sequenceMatches finds runs of letters or digits with a constant step such as
+// "abc", "7531" or "aceg".
+func sequenceMatches(password []byte) []passwordMatch {
+	var matches []passwordMatch
+	add := func(i, j, delta int) {
+		if j-i < 2 || delta == 0 || delta > maxSequenceDelta || delta < -maxSequenceDelta {
+			return
+		}
+		class := byteClass(password[i])
+		if class == 0 {
+			return
+		}
+		for _, c := range password[i : j+1] {
+			if byteClass(c) != class {
+				return
+			}
+		}
+		matches = append(matches, passwordMatch{
+			pattern: patternSequence,
+			i:       i,
+			j:       j,
+			guesses: sequenceGuesses(password[i], j-i+1, delta > 0),
+		})
+	}
+
+	i, lastDelta := 0, 0
+	for k := 1; k < len(password); k++ {
+		delta := int(password[k]) - int(password[k-1])
+		if k == 1 {
+			lastDelta = delta
+		}
+		if delta == lastDelta {
+			continue
+		}
+		add(i, k-1, lastDelta)
+		i, lastDelta = k-1, delta
+	}
+	add(i, len(password)-1, lastDelta)
+	return matches
+}
+
+// byteClass returns 1 for lower case letters, 2 for upper case letters, 3 for
+// digits and 0 for any other byte.
+func byteClass(c byte) int {
+	switch {
+	case 'a' <= c && c <= 'z':
+		return 1
+	case 'A' <= c && c <= 'Z':
+		return 2
+	case '0' <= c && c <= '9':
+		return 3
+	}
+	return 0
+}
+
+func sequenceGuesses(first byte, length int, ascending bool) float64 {
+	var base float64
+	switch {
+	case strings.IndexByte("aAzZ019", first) >= 0:
+		base = 4
+	case byteClass(first) == 3:
+		base = 10
+	default:
+		base = 26
+	}
+	if !ascending {
+		base *= 2
+	}
+	return base * float64(length)
+}
+
+// dateSplits are the positions at which dates without separators of each
+// length are split into day, month and year.
+var dateSplits = map[int][][2]int{
+	4: {{1, 2}, {2, 3}},
+	5: {{1, 3}, {2, 3}},
+	6: {{1, 2}, {2, 4}, {4, 5}},
+	7: {{1, 3}, {2, 3}, {4, 5}, {4, 6}},
+	8: {{2, 4}, {4, 6}},
+}
+
+func dateMatches(password []byte) []passwordMatch {
+	var matches []passwordMatch
+	referenceYear := time.Now().Year()
+
+	for i := 0; i+4 <= len(password); i++ {
+		if year, ok := digitsValue(password[i : i+4]); ok && year >= 1900 && year <= 2099 {
+			matches = append(matches, passwordMatch{
+				pattern: patternYear,
+				i:       i,
+				j:       i + 3,
+				guesses: math.Max(math.Abs(float64(year-referenceYear)), minYearSpace),
+			})
+		}
+	}
+
+	for i := range password {
+		for j := i + 3; j < i+8 && j < len(password); j++ {
+			token := password[i : j+1]
+			if _, ok := digitsValue(token); !ok {
+				break
+			}
+
+			bestYear, found := 0, false
+			for _, split := range dateSplits[len(token)] {
+				year, ok := dateYear(token[:split[0]], token[split[0]:split[1]], token[split[1]:])
+				if ok && (!found || abs(year-referenceYear) < abs(bestYear-referenceYear)) {
+					bestYear, found = year, true
+				}
+			}
+			if found {
+				matches = append(matches, passwordMatch{
+					pattern: patternDate,
+					i:       i,
+					j:       j,
+					guesses: dateGuesses(bestYear, referenceYear, false),
+				})
+			}
+		}
+	}
+
+	for i := range password {
+		for j := i + 5; j < i+10 && j < len(password); j++ {
+			if year, ok := separatedDate(password[i : j+1]); ok {
+				matches = append(matches, passwordMatch{
+					pattern: patternDate,
+					i:       i,
+					j:       j,
+					guesses: dateGuesses(year, referenceYear, true),
+				})
+			}
+		}
+	}
+	return matches
+}
+
+// separatedDate returns the year of a date such as 1/1/91 or 2001-12-31.
+func separatedDate(token []byte) (int, bool) {
+	first := digitRun(token)
+	if first < 1 || first > 4 || first >= len(token) || !isDateSeparator(token[first]) {
+		return 0, false
+	}
+	separator := token[first]
+
+	middleStart := first + 1
+	middle := middleStart + digitRun(token[middleStart:])
+	if middle == middleStart || middle-middleStart > 2 || middle >= len(token) || token[middle] != separator {
+		return 0, false
+	}
+
+	last := token[middle+1:]
+	if len(last) < 1 || len(last) > 4 || digitRun(last) != len(last) {
+		return 0, false
+	}
+	return dateYear(token[:first], token[middleStart:middle], last)
+}
+
+func isDateSeparator(c byte) bool {
+	return strings.IndexByte(" /\\_.-", c) >= 0
+}
+
+// dateYear returns the year of the date made of three groups of digits if the
+// year is the first or last group and the others are a day and month.
+func dateYear(first, middle, last []byte) (int, bool) {
+	for _, parts := range [][3][]byte{{last, first, middle}, {first, middle, last}} {
+		year, ok := parseDateYear(parts[0])
+		if !ok || len(parts[1]) > 2 || len(parts[2]) > 2 {
+			continue
+		}
+		a, _ := digitsValue(parts[1])
+		b, _ := digitsValue(parts[2])
+		if validDayMonth(a, b) || validDayMonth(b, a) {
+			return year, true
+		}
+	}
+	return 0, false
+}
+
+func parseDateYear(digits []byte) (int, bool) {
+	year, ok := digitsValue(digits)
+	if !ok {
+		return 0, false
+	}
+
+	switch len(digits) {
+	case 2:
+		if year > 50 {
+			return 1900 + year, true
+		}
+		return 2000 + year, true
+	case 4:
+		return year, year >= 1000 && year <= 2050
+	}
+	return 0, false
+}
+
+func validDayMonth(day, month int) bool {
+	return day >= 1 && day <= 31 && month >= 1 && month <= 12
+}
+
+func dateGuesses(year, referenceYear int, separator bool) float64 {
+	guesses := math.Max(math.Abs(float64(year-referenceYear)), minYearSpace) * 365
+	if separator {
+		guesses *= 4
+	}
+	return guesses
+}
+
+func bruteforceGuesses(length int) float64 {
+	minimum := float64(minSubmatchGuessesMultiChar + 1)
+	if length == 1 {
+		minimum = minSubmatchGuessesSingleChar + 1
+	}
+	return math.Min(math.Max(math.Pow(10, float64(length)), minimum), math.MaxFloat64)
+}
+
+func passwordFeedback(score int, sequence []passwordMatch) (PasswordWarning, []PasswordSuggestion) {
+	if len(sequence) == 0 || score > 2 {
+		return PasswordWarningNone, nil
+	}
+
+	longest := sequence[0]
+	for _, m := range sequence[1:] {
+		if m.j-m.i > longest.j-longest.i {
+			longest = m
+		}
+	}
+
+	suggestions := []PasswordSuggestion{PasswordSuggestionAddWords}
+	switch longest.pattern {
+	case patternDictionary:
+		if longest.capitalized {
+			suggestions = append(suggestions, PasswordSuggestionAvoidCapitalization)
+		}
+		if longest.reversed {
+			suggestions = append(suggestions, PasswordSuggestionAvoidReversed)
+		}
+		if longest.l33t {
+			suggestions = append(suggestions, PasswordSuggestionAvoidSubstitutions)
+		}
+		return dictionaryWarning(longest, len(sequence) == 1), suggestions
+	case patternSpatial:
+		warning := PasswordWarningShortKeyboardPattern
+		if longest.turns == 1 {
+			warning = PasswordWarningStraightRow
+		}
+		return warning, append(suggestions, PasswordSuggestionLongerKeyboardPattern)
+	case patternRepeat:
+		return PasswordWarningRepeat, append(suggestions, PasswordSuggestionAvoidRepeats)
+	case patternSequence:
+		return PasswordWarningSequence, append(suggestions, PasswordSuggestionAvoidSequences)
+	case patternDate:
+		return PasswordWarningDate, append(suggestions, PasswordSuggestionAvoidDates)
+	case patternYear:
+		return PasswordWarningRecentYear, append(suggestions, PasswordSuggestionAvoidDates)
+	}
+	return PasswordWarningNone, suggestions
+}
+
+func dictionaryWarning(m passwordMatch, soleMatch bool) PasswordWarning {
+	switch m.dictionary {
+	case dictionaryPasswords:
+		if soleMatch && !m.l33t && !m.reversed {
+			return PasswordWarningCommon
+		}
+		if m.guesses <= 1e4 {
+			return PasswordWarningSimilarToCommon
+		}
+	case dictionaryWords:
+		if soleMatch {
+			return PasswordWarningWordByItself
+		}
+	case dictionaryUserInputs:
+		return PasswordWarningUserInput
+	}
+	return PasswordWarningNone
+}
+
+func reversedBytes(b []byte) []byte {
+	reversed := make([]byte, len(b))
+	for i, c := range b {
+		reversed[len(b)-1-i] = c
+	}
+	return reversed
+}
+
+// digitRun returns the number of leading digits of b.
+func digitRun(b []byte) int {
+	for i, c := range b {
+		if c < '0' || c > '9' {
+			return i
+		}
+	}
+	return len(b)
+}
+
+// digitsValue returns the value of b if it only contains digits.
+func digitsValue(b []byte) (int, bool) {
+	if len(b) == 0 || digitRun(b) != len(b) {
+		return 0, false
+	}
+	var value int
+	for _, c := range b {
+		value = value*10 + int(c-'0')
+	}
+	return value, true
+}
+
+func binomial(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+	return result
+}
+
+func factorial(n int) float64 {
+	result := 1.0
+	for i := 2; i <= n; i++ {
+		result *= float64(i)
+	}
+	return result
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,48 @@
+package wallet
+
+import (
+	"testing"
+)
+
+func TestEstimatePasswordStrength(t *testing.T) {
+	tests := []struct {
+		password   string
+		userInputs []string
+		maxScore   int
+		minScore   int
+		warning    PasswordWarning
+	}{
+		{password: "", maxScore: 0, warning: PasswordWarningNone},
+		{password: "password", maxScore: 0, warning: PasswordWarningCommon},
+		{password: "decred123", maxScore: 1},
+		{password: "dfghjkl", maxScore: 1, warning: PasswordWarningStraightRow},
+		{password: "abcabcabcabc", maxScore: 1, warning: PasswordWarningRepeat},
+		{password: "19/05/1990", maxScore: 1, warning: PasswordWarningDate},
+		{password: "P@ssw0rd", maxScore: 1},
+		{password: "savings2022", userInputs: []string{"My Savings"}, maxScore: 2},
+		{password: "tX9#vq2!Lm8@pZ", minScore: 4, maxScore: 4},
+		{password: "correct horse battery staple", minScore: 3, maxScore: 4},
+	}
+
+	for _, test := range tests {
+		strength := EstimatePasswordStrength([]byte(test.password), test.userInputs...)
+		if strength.Score < test.minScore || strength.Score > test.maxScore {
+			t.Errorf("%q: score %d, want %d to %d", test.password, strength.Score, test.minScore, test.maxScore)
+		}
+		if test.warning != PasswordWarningNone && strength.Warning != test.warning {
+			t.Errorf("%q: warning %d, want %d", test.password, strength.Warning, test.warning)
+		}
+	}
+}
+
+func TestEstimatePasswordStrengthUserInputs(t *testing.T) {
+	password := []byte("zqxwalletvjk")
+	withoutName := EstimatePasswordStrength(password)
+	withName := EstimatePasswordStrength(password, "Zqxwalletvjk")
+	if withName.Guesses >= withoutName.Guesses {
+		t.Fatalf("wallet name did not lower guesses: %v >= %v", withName.Guesses, withoutName.Guesses)
+	}
+	if withName.Warning != PasswordWarningUserInput {
+		t.Fatalf("warning %d, want %d", withName.Warning, PasswordWarningUserInput)
+	}
+}
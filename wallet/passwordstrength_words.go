@@ -0,0 +1,139 @@
+package wallet
+
+// commonPasswords are frequently used passwords and words related to
+// cryptocurrency wallets, most common first.
+const commonPasswords = `
+123456 password 12345678 qwerty 123456789 12345 1234 111111 1234567 dragon
+123123 baseball abc123 football monkey letmein 696969 shadow master 666666
+qwertyuiop 123321 mustang 1234567890 michael 654321 superman 1qaz2wsx 7777777
+121212 000000 qazwsx 123qwe killer trustno1 jordan jennifer zxcvbnm asdfgh
+hunter buster soccer harley batman andrew tigger sunshine iloveyou 2000
+charlie robert thomas hockey ranger daniel starwars klaster 112233 george
+computer michelle jessica pepper 1111 zxcvbn 555555 11111111 131313 freedom
+777777 pass maggie 159753 aaaaaa ginger princess joshua cheese amanda summer
+love ashley nicole chelsea biteme matthew access yankees 987654321 dallas
+austin thunder taylor matrix mobilemail mom monitor monitoring montana moon
+moscow william corvette hello martin heather secret merlin diamond 1234qwer
+gfhjkm hammer silver 222222 88888888 anthony justin test bailey q1w2e3r4t5
+patrick internet scooter orange 11111 golfer cookie richard samantha bigdog
+guitar jackson whatever mickey chicken sparky snoopy maverick phoenix camaro
+peanut morgan welcome falcon cowboy ferrari samsung andrea smokey steelers
+joseph mercedes dakota arsenal eagles melissa boomer booboo spider nascar
+monster tigers yellow xxxxxx 123123123 gateway marina diablo bulldog qwer1234
+compaq purple hardcore banana junior hannah 123654 porsche lakers iceman money
+cowboys 987654 london tennis 999999 ncc1701 coffee scooby 0000 miller boston
+q1w2e3r4 brandon yamaha chester mother forever johnny edward 333333 oliver
+redsox player nikita knight fender barney midnight please brandy chicago
+badboy slayer rangers charles angel flower rabbit wizard bigdick jasper enter
+rachel chris steven winner adidas victoria natasha 1q2w3e4r jasmine winter
+prince panties marine ghbdtn fishing cocacola casper james 232323 raiders
+888888 marlboro gandalf asdfasdf crystal 87654321 12344321 golden 8675309
+changeme passw0rd p@ssw0rd password1 password123 qwerty123 admin admin123
+root toor default guest login abcdef abcd1234 zaq12wsx 1q2w3e 1qaz2wsx3edc
+decred dcr decred123 decredwallet mydecred stakey politeia lightning godcr
+bitcoin btc satoshi nakamoto crypto cryptocurrency blockchain wallet mywallet
+hodl hodler tothemoon lambo ethereum litecoin dogecoin mining miner ticket
+tickets staking stake voting treasury altcoin exchange coinbase ledger trezor
+seed seedphrase privatekey passphrase spending mnemonic
+`
+
+// englishWords are common English words and names, most common first.
+const englishWords = `
+the and that have for not with you this but his from they say her she will
+one all would there their what out about who get which when make can like
+time just him know take people into year your good some could them see other
+than then now look only come its over think also back after use two how our
+work first well way even new want because any these give day most
+man find here thing many tell very through long where much should school
+still try last ask need too feel three state never become high really
+something most another family own leave put old while mean keep student
+why let great same big group begin seem country help talk turn problem every
+start hand might american show part against place such again few case week
+company system each right program hear question during play government run
+small number off always move night live point believe hold today bring happen
+next without before large million must home under water room write mother
+area national money story young fact month different lot study book eye job
+word business issue side kind four head far black long both little house
+yes since provide service around friend important father sit away until power
+hour game often yet line political end among ever stand bad lose however
+member pay law meet car city almost include continue set later community much
+name five once white least president learn real change team minute best
+several idea kid body information nothing ago lead social understand whether
+watch together follow parent stop face anything create public already speak
+others read level allow add office spend door health person art sure war
+history party within grow result open morning walk reason low win research
+girl guy early food moment himself air teacher force offer enough education
+across although remember foot second boy maybe toward able age policy
+everything love process music including consider appear actually buy probably
+human wait serve market die send expect sense build stay fall oh nation plan
+cut college interest death course someone experience behind reach local kill
+six remain effect yeah suggest class control raise care perhaps little late
+hard field else pass former sell major sometimes require along development
+themselves report role better economic effort decide rate strong possible
+heart drug show leader light voice wife whole police mind finally pull return
+free military price less according decision explain son hope develop view
+relationship carry town road drive arm true federal break difference thank
+receive value international building action full model join season society
+tax director position player agree especially record pick wear paper special
+space ground form support event official whose matter everyone center couple
+site project hit base activity star table need court american produce eat
+teach oil half situation easy cost industry figure street image itself phone
+either data cover quite picture clear practice piece land recent describe
+product doctor wall patient worker news test movie certain north personal
+simply third technology catch step baby computer type attention draw film
+tree source red nearly organization choose cause hair century evidence window
+difficult listen soon culture billion chance brother energy period summer
+realize hundred available plant likely opportunity term short letter
+condition choice single rule daughter administration south husband floor
+campaign material population economy medical hospital church close thousand
+risk current fire future wrong involve defense anyone increase security bank
+myself certainly west sport board seek per subject officer private rest
+behavior deal performance fight throw top quickly past goal bed order author
+fill represent focus foreign drop blood upon agency push nature color recently
+store reduce sound note fine near movement page enter share common poor
+natural race concern series significant similar hot language each usually
+response dead rise animal factor decade article shoot east save seven
+artist away scene stock career despite central eight thus treatment beyond
+happy exactly protect approach lie size dog fund serious occur media ready
+sign thought list individual simple quality pressure accept answer resource
+identify left meeting determine prepare disease whatever success argue cup
+particularly amount ability staff recognize indicate character growth loss
+degree wonder attack herself region television box training pretty trade
+election everybody physical lay general feeling standard bill message fail
+outside arrive analysis benefit sex forward lawyer present section
+environmental glass skill sister professor operation financial crime stage
+ought compare authority miss design sort act ten knowledge gun station blue
+state strategy clearly discuss indeed truth song example democratic check
+environment leg dark various rather laugh guess executive prove hang
+entire rock forget claim remove manager enjoy network legal religious cold
+final main science green memory card above seat cell establish nice trial
+expert spring firm radio visit management avoid imagine tonight huge ball
+finish yourself theory impact respond statement maintain charge popular
+traditional onto reveal direction weapon employee cultural contain peace
+pain apply play measure wide shake fly interview manage chair fish
+particular camera structure politics perform bit weight suddenly discover
+candidate production treat trip evening affect inside conference unit style
+adult worry range mention deep edge specific writer trouble necessary
+throughout challenge fear shoulder institution middle sea dream bar beautiful
+property instead improve stuff correct horse battery staple dragon monkey
+sunshine princess football baseball soccer hockey summer winter autumn spring
+january february march april may june july august september october november
+december monday tuesday wednesday thursday friday saturday sunday
+john james robert michael william david richard joseph thomas charles mary
+patricia jennifer linda elizabeth barbara susan jessica sarah karen daniel
+matthew anthony mark donald steven paul andrew joshua kenneth kevin brian
+george edward ronald timothy jason jeffrey ryan jacob gary nicholas eric
+nancy lisa betty margaret sandra ashley kimberly emily donna michelle dorothy
+carol amanda melissa deborah stephanie rebecca sharon laura cynthia kathleen
+amy angela shirley anna brenda pamela emma nicole helen samantha katherine
+christine debra rachel carolyn janet catherine maria heather diane ruth julie
+olivia joyce virginia victoria kelly lauren christina joan evelyn judith
+megan andrea cheryl hannah jacqueline martha gloria teresa ann sara madison
+frances kathryn janice jean abigail alice judy sophia grace denise amber doris
+marilyn danielle beverly isabella theresa diana natalie brittany charlotte
+marie kayla alexis lori smith johnson williams brown jones garcia miller
+davis rodriguez martinez hernandez lopez gonzalez wilson anderson taylor
+moore jackson martin lee perez thompson harris sanchez clark ramirez lewis
+robinson walker young allen king wright scott torres nguyen hill flores green
+adams nelson baker hall rivera campbell mitchell carter roberts
+`
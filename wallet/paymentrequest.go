@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// paymentURIScheme is the scheme of decred payment URIs.
+const paymentURIScheme = "decred"
+
+// ErrInvalidPaymentURI is returned when a payment URI cannot be parsed.
+var ErrInvalidPaymentURI = errors.New("invalid payment URI")
+
+// PaymentRequest is a payment asked for with a payment URI such as
+// decred:Dsaddress?amount=1.5.
+type PaymentRequest struct {
+	Address string
+
+	// Amount is the amount to pay in atoms, or 0 if it is not set.
+	Amount int64
+
+	Label   string
+	Message string
+}
+
+// ParsePaymentURI parses a decred payment URI. The address is not checked
+// against the network in use.
+func ParsePaymentURI(uri string) (*PaymentRequest, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || !strings.EqualFold(u.Scheme, paymentURIScheme) {
+		return nil, ErrInvalidPaymentURI
+	}
+
+	// decred:address is parsed as opaque, decred://address as a host.
+	address := u.Opaque
+	if address == "" {
+		address = u.Host
+	}
+	if address == "" {
+		return nil, ErrInvalidPaymentURI
+	}
+
+	query := u.Query()
+	req := &PaymentRequest{
+		Address: address,
+		Label:   query.Get("label"),
+		Message: query.Get("message"),
+	}
+	if amount := query.Get("amount"); amount != "" {
+		coins, err := strconv.ParseFloat(amount, 64)
+		if err != nil || coins <= 0 {
+			return nil, ErrInvalidPaymentURI
+		}
+		atoms, err := dcrutil.NewAmount(coins)
+		if err != nil {
+			return nil, ErrInvalidPaymentURI
+		}
+		req.Amount = int64(atoms)
+	}
+	return req, nil
+}
+
+// OpenArgs handles the command line arguments the app was launched with.
+// The payment URIs are kept for the send page, see TakePaymentRequest.
+func (wal *Wallet) OpenArgs(args []string) {
+	for _, arg := range args {
+		req, err := ParsePaymentURI(arg)
+		if err != nil {
+			log.Warnf("Ignoring argument %q: %v", arg, err)
+			continue
+		}
+
+		wal.paymentRequestsMu.Lock()
+		wal.paymentRequests = append(wal.paymentRequests, req)
+		wal.paymentRequestsMu.Unlock()
+	}
+}
+
+// TakePaymentRequest returns the oldest payment request that has not been
+// opened, or nil if there is none.
+func (wal *Wallet) TakePaymentRequest() *PaymentRequest {
+	wal.paymentRequestsMu.Lock()
+	defer wal.paymentRequestsMu.Unlock()
+	if len(wal.paymentRequests) == 0 {
+		return nil
+	}
+	req := wal.paymentRequests[0]
+	wal.paymentRequests = wal.paymentRequests[1:]
+	return req
+}
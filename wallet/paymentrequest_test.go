@@ -0,0 +1,39 @@
+package wallet
+
+import "testing"
+
+func TestParsePaymentURI(t *testing.T) {
+	tests := []struct {
+		uri     string
+		address string
+		amount  int64
+		message string
+		valid   bool
+	}{
+		{uri: "decred:DsAddress", address: "DsAddress", valid: true},
+		{uri: "decred://DsAddress?amount=1.5", address: "DsAddress", amount: 150000000, valid: true},
+		{uri: "Decred:DsAddress?amount=0.00000001&message=Order%2042", address: "DsAddress", amount: 1, message: "Order 42", valid: true},
+		{uri: "bitcoin:1Address", valid: false},
+		{uri: "decred:", valid: false},
+		{uri: "decred:DsAddress?amount=-1", valid: false},
+		{uri: "decred:DsAddress?amount=one", valid: false},
+		{uri: "--network=testnet", valid: false},
+	}
+
+	for _, test := range tests {
+		req, err := ParsePaymentURI(test.uri)
+		if !test.valid {
+			if err == nil {
+				t.Errorf("%s: parsed as %+v", test.uri, req)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.uri, err)
+			continue
+		}
+		if req.Address != test.address || req.Amount != test.amount || req.Message != test.message {
+			t.Errorf("%s: got %+v", test.uri, req)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+// maxPeerEvents is the number of connection events kept in memory.
+const maxPeerEvents = 200
+
+// PeerEventType is the kind of a connection event.
+type PeerEventType int
+
+const (
+	PeerConnected PeerEventType = iota
+	PeerDisconnected
+	SyncStartedEvent
+	SyncStoppedEvent
+	SyncFailedEvent
+)
+
+// PeerEvent is an entry of the connection event log.
+type PeerEvent struct {
+	Time time.Time
+	Type PeerEventType
+
+	// Addr and UserAgent are set for peer events.
+	Addr      string
+	UserAgent string
+
+	// Error is set for SyncFailedEvent.
+	Error string
+}
+
+// peerLog records peer connections and disconnections. dcrlibwallet only
+// reports the number of connected peers, so the peer list is compared with
+// the one seen on the previous change.
+type peerLog struct {
+	mu     sync.Mutex
+	events []PeerEvent
+	known  map[string]dcrlibwallet.PeerInfo
+}
+
+func (pl *peerLog) add(event PeerEvent) {
+	pl.events = append(pl.events, event)
+	if len(pl.events) > maxPeerEvents {
+		pl.events = pl.events[len(pl.events)-maxPeerEvents:]
+	}
+}
+
+// record adds an event that is not about a single peer.
+func (pl *peerLog) record(eventType PeerEventType, err error) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	event := PeerEvent{Time: time.Now(), Type: eventType}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	pl.add(event)
+}
+
+// update logs the difference between peers and the previously connected
+// peers.
+func (pl *peerLog) update(peers []dcrlibwallet.PeerInfo) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	now := time.Now()
+	connected := make(map[string]dcrlibwallet.PeerInfo, len(peers))
+	for _, peer := range peers {
+		connected[peer.Addr] = peer
+		if _, ok := pl.known[peer.Addr]; !ok {
+			pl.add(PeerEvent{Time: now, Type: PeerConnected, Addr: peer.Addr, UserAgent: peer.SubVer})
+		}
+	}
+	for addr, peer := range pl.known {
+		if _, ok := connected[addr]; !ok {
+			pl.add(PeerEvent{Time: now, Type: PeerDisconnected, Addr: addr, UserAgent: peer.SubVer})
+		}
+	}
+	pl.known = connected
+}
+
+// ConnectedPeers returns the peers the wallets are syncing with.
+func (wal *Wallet) ConnectedPeers() []dcrlibwallet.PeerInfo {
+	if wal.multi == nil || !wal.multi.IsConnectedToDecredNetwork() {
+		return nil
+	}
+	peers, err := wal.multi.PeerInfoRaw()
+	if err != nil {
+		return nil
+	}
+	return peers
+}
+
+// PeerEvents returns the connection event log, oldest first.
+func (wal *Wallet) PeerEvents() []PeerEvent {
+	pl := &wal.syncMonitor.peers
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	return append([]PeerEvent(nil), pl.events...)
+}
+
+// PersistentPeers returns the peers that the wallets sync with instead of
+// peers found on the network.
+func (wal *Wallet) PersistentPeers() []string {
+	peers := wal.multi.ReadStringConfigValueForKey(dcrlibwallet.SpvPersistentPeerAddressesConfigKey)
+	if peers == "" {
+		return nil
+	}
+	return strings.Split(peers, ";")
+}
+
+// SavePersistentPeers validates and saves the persistent peers. Addresses
+// without a port get the default port of the network. The peers are used
+// from the next sync.
+func (wal *Wallet) SavePersistentPeers(peers []string) error {
+	seen := make(map[string]bool, len(peers))
+	addrs := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		addr, err := wal.NormalizePeerAddress(peer)
+		if err != nil {
+			return err
+		}
+		if seen[addr] {
+			return fmt.Errorf("peer %s is listed twice", addr)
+		}
+		seen[addr] = true
+		addrs = append(addrs, addr)
+	}
+
+	wal.multi.SaveUserConfigValue(dcrlibwallet.SpvPersistentPeerAddressesConfigKey, strings.Join(addrs, ";"))
+	return nil
+}
+
+// NormalizePeerAddress returns addr as host:port, adding the default port of
+// the network if it has none.
+func (wal *Wallet) NormalizePeerAddress(addr string) (string, error) {
+	addr = strings.TrimSpace(addr)
+	if addr == "" {
+		return "", errors.New("the peer address is empty")
+	}
+
+	normalized, err := dcrlibwallet.NormalizeAddress(addr, wal.network().peerPort)
+	if err != nil {
+		return "", fmt.Errorf("invalid peer address %s: %v", addr, err)
+	}
+
+	host, port, _ := net.SplitHostPort(normalized)
+	if host == "" || strings.ContainsAny(host, " /;") {
+		return "", fmt.Errorf("invalid peer host %q", host)
+	}
+	if n, err := strconv.ParseUint(port, 10, 16); err != nil || n == 0 {
+		return "", fmt.Errorf("invalid peer port %s", port)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+func TestNormalizePeerAddress(t *testing.T) {
+	wal := &Wallet{Net: dcrlibwallet.Testnet3}
+
+	tests := []struct {
+		addr, want string
+		wantErr    bool
+	}{
+		{addr: "127.0.0.1", want: "127.0.0.1:19108"},
+		{addr: " 10.0.0.1:9108 ", want: "10.0.0.1:9108"},
+		{addr: "::1", want: "[::1]:19108"},
+		{addr: "node.example.org", want: "node.example.org:19108"},
+		{addr: "", wantErr: true},
+		{addr: "10.0.0.1:port", wantErr: true},
+		{addr: "10.0.0.1:70000", wantErr: true},
+		{addr: ":9108", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := wal.NormalizePeerAddress(test.addr)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("NormalizePeerAddress(%q) = %q, want error", test.addr, got)
+			}
+			continue
+		}
+		if err != nil || got != test.want {
+			t.Errorf("NormalizePeerAddress(%q) = %q, %v, want %q", test.addr, got, err, test.want)
+		}
+	}
+}
+
+func TestPeerLogUpdate(t *testing.T) {
+	var pl peerLog
+	a := dcrlibwallet.PeerInfo{Addr: "10.0.0.1:9108", SubVer: "/dcrd:1.7.0/"}
+	b := dcrlibwallet.PeerInfo{Addr: "10.0.0.2:9108", SubVer: "/dcrd:1.7.1/"}
+
+	pl.update([]dcrlibwallet.PeerInfo{a})
+	pl.update([]dcrlibwallet.PeerInfo{a, b})
+	pl.update([]dcrlibwallet.PeerInfo{b})
+
+	want := []PeerEvent{
+		{Type: PeerConnected, Addr: a.Addr, UserAgent: a.SubVer},
+		{Type: PeerConnected, Addr: b.Addr, UserAgent: b.SubVer},
+		{Type: PeerDisconnected, Addr: a.Addr, UserAgent: a.SubVer},
+	}
+	if len(pl.events) != len(want) {
+		t.Fatalf("got %d events, want %d", len(pl.events), len(want))
+	}
+	for i, event := range pl.events {
+		event.Time = want[i].Time
+		if event != want[i] {
+			t.Errorf("event %d is %+v, want %+v", i, event, want[i])
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+// DefaultProfileName is the name of the profile that uses the app data
+// directory and network from the app config. It always exists and cannot be
+// renamed or deleted.
+const DefaultProfileName = "default"
+
+const (
+	profilesFileName = "profiles.json"
+	profilesDirName  = "profiles"
+
+	maxProfileNameLength = 50
+)
+
+// ProfileNetworks are the networks that new profiles may use.
+var ProfileNetworks = []string{dcrlibwallet.Mainnet, dcrlibwallet.Testnet3}
+
+var (
+	// ErrProfileExists is returned when a profile with the same name exists.
+	ErrProfileExists = errors.New("a profile with this name already exists")
+
+	// ErrProfileNotExist is returned when no profile has the given name.
+	ErrProfileNotExist = errors.New("profile does not exist")
+
+	// ErrDefaultProfile is returned when renaming or deleting the default
+	// profile.
+	ErrDefaultProfile = errors.New("the default profile cannot be changed")
+
+	// ErrProfileInUse is returned when deleting the loaded profile.
+	ErrProfileInUse = errors.New("the profile is in use")
+)
+
+// Profile is a named app setup with its own app data directory, network and
+// settings.
+type Profile struct {
+	Name    string `json:"name"`
+	Network string `json:"network"`
+	Dir     string `json:"dir"`
+}
+
+// IsDefault returns true if p is the default profile.
+func (p Profile) IsDefault() bool {
+	return p.Name == DefaultProfileName
+}
+
+// Profiles returns the default profile followed by the profiles created by
+// the user.
+func (wal *Wallet) Profiles() ([]Profile, error) {
+	profiles, err := wal.readProfiles()
+	if err != nil {
+		return nil, err
+	}
+	return append([]Profile{wal.defaultProfile()}, profiles...), nil
+}
+
+// ProfileName returns the name of the selected profile.
+func (wal *Wallet) ProfileName() string {
+	return wal.profileName
+}
+
+// SelectProfile sets the app data directory and network of the wallet to
+// those of the profile named name. It must be called before InitMultiWallet.
+func (wal *Wallet) SelectProfile(name string) error {
+	if wal.multi != nil {
+		return errors.New("cannot change the profile of a loaded multiwallet")
+	}
+
+	profiles, err := wal.Profiles()
+	if err != nil {
+		return err
+	}
+	i := profileIndex(profiles, name)
+	if i == -1 {
+		return ErrProfileNotExist
+	}
+
+	wal.Root, wal.Net, wal.profileName = profiles[i].Dir, profiles[i].Network, profiles[i].Name
+	return nil
+}
+
+// CreateProfile adds a profile named name that uses network. The app data of
+// the profile is kept in a new directory under the app's home directory.
+func (wal *Wallet) CreateProfile(name, network string) (Profile, error) {
+	name = strings.TrimSpace(name)
+	if err := validateProfileName(name); err != nil {
+		return Profile{}, err
+	}
+	if !validProfileNetwork(network) {
+		return Profile{}, fmt.Errorf("unsupported profile network %q", network)
+	}
+
+	profiles, err := wal.readProfiles()
+	if err != nil {
+		return Profile{}, err
+	}
+	if strings.EqualFold(name, DefaultProfileName) || profileIndex(profiles, name) != -1 {
+		return Profile{}, ErrProfileExists
+	}
+
+	dir, err := newProfileDir(filepath.Join(wal.homeDir, profilesDirName), name)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	profile := Profile{Name: name, Network: network, Dir: dir}
+	if err = wal.writeProfiles(append(profiles, profile)); err != nil {
+		return Profile{}, err
+	}
+	return profile, nil
+}
+
+// RenameProfile changes the name of the profile named name to newName. The
+// app data directory of the profile is not moved.
+func (wal *Wallet) RenameProfile(name, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if strings.EqualFold(name, DefaultProfileName) {
+		return ErrDefaultProfile
+	}
+	if err := validateProfileName(newName); err != nil {
+		return err
+	}
+
+	profiles, err := wal.readProfiles()
+	if err != nil {
+		return err
+	}
+	i := profileIndex(profiles, name)
+	if i == -1 {
+		return ErrProfileNotExist
+	}
+	if j := profileIndex(profiles, newName); strings.EqualFold(newName, DefaultProfileName) || j != -1 && j != i {
+		return ErrProfileExists
+	}
+
+	if wal.profileName == profiles[i].Name {
+		wal.profileName = newName
+	}
+	profiles[i].Name = newName
+	return wal.writeProfiles(profiles)
+}
+
+// DeleteProfile removes the profile named name from the list of profiles. Its
+// app data directory, including any wallets, is left on disk so that no funds
+// are lost by mistake.
+func (wal *Wallet) DeleteProfile(name string) error {
+	if strings.EqualFold(name, DefaultProfileName) {
+		return ErrDefaultProfile
+	}
+	if wal.multi != nil && strings.EqualFold(name, wal.profileName) {
+		return ErrProfileInUse
+	}
+
+	profiles, err := wal.readProfiles()
+	if err != nil {
+		return err
+	}
+	i := profileIndex(profiles, name)
+	if i == -1 {
+		return ErrProfileNotExist
+	}
+	return wal.writeProfiles(append(profiles[:i], profiles[i+1:]...))
+}
+
+func (wal *Wallet) defaultProfile() Profile {
+	return Profile{Name: DefaultProfileName, Network: wal.defaultNet, Dir: wal.homeDir}
+}
+
+func (wal *Wallet) readProfiles() ([]Profile, error) {
+	data, err := os.ReadFile(filepath.Join(wal.homeDir, profilesFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []Profile
+	if err = json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("invalid profiles file: %v", err)
+	}
+	return profiles, nil
+}
+
+func (wal *Wallet) writeProfiles(profiles []Profile) error {
+	data, err := json.MarshalIndent(profiles, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(wal.homeDir, profilesFileName), data, 0600)
+}
+
+// profileIndex returns the index of the profile named name, ignoring case, or
+// -1 if there is no such profile.
+func profileIndex(profiles []Profile, name string) int {
+	for i, profile := range profiles {
+		if strings.EqualFold(profile.Name, strings.TrimSpace(name)) {
+			return i
+		}
+	}
+	return -1
+}
+
+func validateProfileName(name string) error {
+	if name == "" {
+		return errors.New("profile name cannot be empty")
+	}
+	if len(name) > maxProfileNameLength {
+		return fmt.Errorf("profile name cannot be longer than %d characters", maxProfileNameLength)
+	}
+	return nil
+}
+
+func validProfileNetwork(network string) bool {
+	for _, net := range ProfileNetworks {
+		if net == network {
+			return true
+		}
+	}
+	return false
+}
+
+// newProfileDir creates and returns a directory under parent for a profile
+// named name. The directory is named after the profile, with a number added
+// if the name is already taken.
+func newProfileDir(parent, name string) (string, error) {
+	base := strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z', '0' <= r && r <= '9':
+			return r
+		case 'A' <= r && r <= 'Z':
+			return r + 'a' - 'A'
+		}
+		return '-'
+	}, name)
+	base = strings.Trim(base, "-")
+	if base == "" {
+		base = "profile"
+	}
+
+	if err := os.MkdirAll(parent, 0700); err != nil {
+		return "", err
+	}
+	for n := 1; ; n++ {
+		dir := filepath.Join(parent, base)
+		if n > 1 {
+			dir = fmt.Sprintf("%s-%d", dir, n)
+		}
+		err := os.Mkdir(dir, 0700)
+		if err == nil {
+			return dir, nil
+		}
+		if !os.IsExist(err) {
+			return "", err
+		}
+	}
+}
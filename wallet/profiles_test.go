@@ -0,0 +1,62 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+func TestProfiles(t *testing.T) {
+	homeDir := t.TempDir()
+	wal, err := NewWallet(homeDir, dcrlibwallet.Mainnet, "test", "", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := wal.CreateProfile(" Testnet Setup ", dcrlibwallet.Testnet3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Name != "Testnet Setup" {
+		t.Fatalf("profile name %q not trimmed", profile.Name)
+	}
+	if _, err = wal.CreateProfile("testnet setup", dcrlibwallet.Mainnet); err != ErrProfileExists {
+		t.Fatalf("duplicate profile: got %v, want %v", err, ErrProfileExists)
+	}
+	if _, err = wal.CreateProfile("Default", dcrlibwallet.Mainnet); err != ErrProfileExists {
+		t.Fatalf("default profile name: got %v, want %v", err, ErrProfileExists)
+	}
+	if _, err = wal.CreateProfile("Regtest", "regnet"); err == nil {
+		t.Fatal("created a profile with an unsupported network")
+	}
+
+	if err = wal.RenameProfile("testnet setup", "Staging"); err != nil {
+		t.Fatal(err)
+	}
+	if err = wal.RenameProfile(DefaultProfileName, "Main"); err != ErrDefaultProfile {
+		t.Fatalf("rename default profile: got %v, want %v", err, ErrDefaultProfile)
+	}
+
+	if err = wal.SelectProfile("staging"); err != nil {
+		t.Fatal(err)
+	}
+	if wal.Root != profile.Dir || wal.Net != dcrlibwallet.Testnet3 || wal.ProfileName() != "Staging" {
+		t.Fatalf("selected profile not applied: %s %s %s", wal.Root, wal.Net, wal.ProfileName())
+	}
+
+	profiles, err := wal.Profiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 2 || !profiles[0].IsDefault() || profiles[0].Dir != homeDir {
+		t.Fatalf("unexpected profiles %+v", profiles)
+	}
+
+	if err = wal.DeleteProfile("Staging"); err != nil {
+		t.Fatal(err)
+	}
+	if err = wal.SelectProfile("Staging"); err != ErrProfileNotExist {
+		t.Fatalf("select deleted profile: got %v, want %v", err, ErrProfileNotExist)
+	}
+}
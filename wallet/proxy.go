@@ -0,0 +1,217 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decred/go-socks/socks"
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+const (
+	proxyConfigKey = "socks5_proxy"
+
+	httpRequestTimeout = 30 * time.Second
+)
+
+// ErrDirectConnection is returned when a connection that cannot go through
+// the proxy is attempted in Tor only mode.
+var ErrDirectConnection = errors.New("direct connections are not allowed in Tor only mode")
+
+// ProxyConfig is the SOCKS5 proxy that outbound connections go through.
+type ProxyConfig struct {
+	// Host is the host:port of the proxy. It is empty when no proxy is
+	// used.
+	Host     string `json:"host"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	// StreamIsolation makes the proxy use a separate Tor circuit for each
+	// connection by sending random credentials.
+	StreamIsolation bool `json:"stream_isolation"`
+
+	// TorOnly refuses connections that do not go through the proxy.
+	TorOnly bool `json:"tor_only"`
+}
+
+// Enabled returns true if a proxy is set.
+func (cfg ProxyConfig) Enabled() bool {
+	return cfg.Host != ""
+}
+
+func (cfg ProxyConfig) validate() error {
+	if !cfg.Enabled() {
+		if cfg.TorOnly {
+			return errors.New("Tor only mode requires a proxy")
+		}
+		return nil
+	}
+
+	_, port, err := net.SplitHostPort(cfg.Host)
+	if err != nil {
+		return fmt.Errorf("invalid proxy address %s: %v", cfg.Host, err)
+	}
+	if _, err = strconv.ParseUint(port, 10, 16); err != nil {
+		return fmt.Errorf("invalid proxy port %s", port)
+	}
+	return nil
+}
+
+// SetProxyOverride sets the proxy from the app config. It is used instead of
+// the proxy saved in the settings.
+func (wal *Wallet) SetProxyOverride(cfg ProxyConfig) error {
+	cfg.Host = strings.TrimSpace(cfg.Host)
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	wal.proxyOverride = &cfg
+	wal.resetHTTPClient()
+	return nil
+}
+
+// ProxyOverridden returns true if the proxy is set in the app config and
+// cannot be changed in the settings.
+func (wal *Wallet) ProxyOverridden() bool {
+	return wal.proxyOverride != nil
+}
+
+// ProxyConfig returns the proxy in use.
+func (wal *Wallet) ProxyConfig() ProxyConfig {
+	if wal.proxyOverride != nil {
+		return *wal.proxyOverride
+	}
+
+	var cfg ProxyConfig
+	if wal.multi != nil {
+		wal.multi.ReadUserConfigValue(proxyConfigKey, &cfg)
+	}
+	return cfg
+}
+
+// SaveProxyConfig saves the proxy settings. Connections that are made after
+// it returns use the new proxy.
+func (wal *Wallet) SaveProxyConfig(cfg ProxyConfig) error {
+	if wal.ProxyOverridden() {
+		return errors.New("the proxy is set in the app config")
+	}
+
+	cfg.Host = strings.TrimSpace(cfg.Host)
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	wal.multi.SaveUserConfigValue(proxyConfigKey, cfg)
+	wal.resetHTTPClient()
+	wal.cacheCrashConfig()
+	return nil
+}
+
+// DirectConnectionsAllowed returns false in Tor only mode. dcrlibwallet
+// connects to SPV peers, politeia, VSPs and the DEX without the proxy, so
+// those are turned off when it returns false.
+func (wal *Wallet) DirectConnectionsAllowed() bool {
+	return !wal.ProxyConfig().TorOnly
+}
+
+// httpClient returns the client for the app's own HTTP requests. It connects
+// through the proxy when one is set and is kept until the proxy changes, so
+// that its connections are reused.
+func (wal *Wallet) httpClient() *http.Client {
+	wal.httpMu.Lock()
+	defer wal.httpMu.Unlock()
+	if wal.client == nil {
+		wal.client = newHTTPClient(wal.ProxyConfig())
+	}
+	return wal.client
+}
+
+// resetHTTPClient closes the idle connections of the HTTP client after the
+// proxy changed. The next request makes a client for the new proxy.
+func (wal *Wallet) resetHTTPClient() {
+	wal.httpMu.Lock()
+	defer wal.httpMu.Unlock()
+	if wal.client != nil {
+		wal.client.CloseIdleConnections()
+		wal.client = nil
+	}
+}
+
+// newHTTPClient returns a client that connects through the proxy of cfg when
+// one is set.
+func newHTTPClient(cfg ProxyConfig) *http.Client {
+	if !cfg.Enabled() {
+		return &http.Client{Timeout: httpRequestTimeout}
+	}
+
+	proxy := &socks.Proxy{
+		Addr:         cfg.Host,
+		Username:     cfg.Username,
+		Password:     cfg.Password,
+		TorIsolation: cfg.StreamIsolation,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         proxy.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		Timeout: httpRequestTimeout,
+	}
+}
+
+// SpvSync starts syncing the wallets unless the sync is turned off by
+// offline or Tor only mode. If the sync policy does not allow syncing now,
+// the sync starts when it does.
+func (wal *Wallet) SpvSync() error {
+	if err := wal.FeatureError(FeatureSync); err != nil {
+		if isSyncPolicyError(err) {
+			wal.syncMonitor.setPaused(true)
+		}
+		return err
+	}
+	return wal.multi.SpvSync()
+}
+
+// StartDexClient starts the DEX client unless the DEX is turned off by
+// offline or Tor only mode.
+func (wal *Wallet) StartDexClient() (*dcrlibwallet.DexClient, error) {
+	if err := wal.FeatureError(FeatureDEX); err != nil {
+		return nil, err
+	}
+
+	task := wal.StartTask(TaskDexStart, 0, nil)
+	dc, err := wal.multi.StartDexClient()
+	task.End(err)
+	return dc, err
+}
+
+// StartAccountMixer starts the account mixer of the wallet with walletID
+// unless the mixer is turned off by offline or Tor only mode. The mixer
+// connects to the mixing server directly.
+func (wal *Wallet) StartAccountMixer(walletID int, passphrase string) error {
+	if err := wal.FeatureError(FeatureMixer); err != nil {
+		return err
+	}
+	return wal.multi.StartAccountMixer(walletID, passphrase)
+}
+
+// VSPTicketInfo returns the VSP status of the ticket with hash unless VSPs
+// are turned off by offline or Tor only mode.
+func (wal *Wallet) VSPTicketInfo(walletID int, hash string) (*dcrlibwallet.VSPTicketInfo, error) {
+	if err := wal.FeatureError(FeatureVSP); err != nil {
+		return nil, err
+	}
+	return wal.multi.VSPTicketInfo(walletID, hash)
+}
+
+// SaveVSP fetches the info of the VSP at host and adds it to the known VSPs
+// unless VSPs are turned off by offline or Tor only mode.
+func (wal *Wallet) SaveVSP(host string) error {
+	if err := wal.FeatureError(FeatureVSP); err != nil {
+		return err
+	}
+	return wal.multi.SaveVSP(host)
+}
@@ -0,0 +1,45 @@
+package wallet
+
+import (
+	"testing"
+)
+
+func TestProxyConfigValidate(t *testing.T) {
+	tests := []struct {
+		cfg   ProxyConfig
+		valid bool
+	}{
+		{cfg: ProxyConfig{}, valid: true},
+		{cfg: ProxyConfig{Host: "127.0.0.1:9050", TorOnly: true}, valid: true},
+		{cfg: ProxyConfig{Host: "[::1]:9050"}, valid: true},
+		{cfg: ProxyConfig{TorOnly: true}, valid: false},
+		{cfg: ProxyConfig{Host: "127.0.0.1"}, valid: false},
+		{cfg: ProxyConfig{Host: "127.0.0.1:tor"}, valid: false},
+	}
+
+	for _, test := range tests {
+		err := test.cfg.validate()
+		if (err == nil) != test.valid {
+			t.Errorf("%+v: got error %v, want valid %v", test.cfg, err, test.valid)
+		}
+	}
+}
+
+func TestHTTPClientReused(t *testing.T) {
+	wal := new(Wallet)
+	if err := wal.SetProxyOverride(ProxyConfig{Host: "127.0.0.1:9050"}); err != nil {
+		t.Fatal(err)
+	}
+
+	client := wal.httpClient()
+	if wal.httpClient() != client {
+		t.Fatal("a new client was made for the same proxy")
+	}
+
+	if err := wal.SetProxyOverride(ProxyConfig{Host: "127.0.0.1:9150"}); err != nil {
+		t.Fatal(err)
+	}
+	if wal.httpClient() == client {
+		t.Fatal("the client was reused after the proxy changed")
+	}
+}
@@ -0,0 +1,275 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+const (
+	// rescanRateConfigKey stores the number of blocks scanned per second,
+	// averaged over the previous rescans.
+	rescanRateConfigKey = "rescan_rate"
+
+	// heightMarginFromDate is how far back from the height estimated for a
+	// date a rescan starts, since blocks are not found at a steady rate.
+	heightMarginFromDate = 24 * time.Hour
+
+	// rescanStartTimeout is how long a queued rescan waits for the previous
+	// one to be cleaned up.
+	rescanStartTimeout = 10 * time.Second
+)
+
+// ErrInvalidRescanHeight is returned when a rescan start height is above the
+// best block.
+var ErrInvalidRescanHeight = errors.New("the start height is above the best block")
+
+// RescanRequest is a rescan of a single wallet.
+type RescanRequest struct {
+	WalletID    int
+	StartHeight int32
+}
+
+// rescanQueue runs queued rescans one after the other and measures their
+// speed. It is the dcrlibwallet blocks rescan listener and passes the
+// notifications on to the listener set with SetRescanProgressListener.
+type rescanQueue struct {
+	wal *Wallet
+
+	mu      sync.Mutex
+	pending []RescanRequest
+	current *RescanRequest
+	started time.Time
+	scanned int32
+	next    dcrlibwallet.BlocksRescanProgressListener
+
+	// task is the registered task of the running rescan. canceled is set
+	// when it is canceled by the user.
+	task     *Task
+	canceled bool
+
+	// rate is the saved rescan rate in blocks per second.
+	rate float64
+}
+
+// SetRescanProgressListener sets the listener that receives the rescan
+// notifications of the loaded wallets.
+func (wal *Wallet) SetRescanProgressListener(listener dcrlibwallet.BlocksRescanProgressListener) {
+	wal.rescanQueue.mu.Lock()
+	wal.rescanQueue.next = listener
+	wal.rescanQueue.mu.Unlock()
+}
+
+// RescanFromHeight rescans the blocks of the wallets with walletIDs from
+// startHeight, one wallet at a time. The rescans are added to the queue if a
+// rescan is running. An error is returned if the first rescan cannot start.
+func (wal *Wallet) RescanFromHeight(walletIDs []int, startHeight int32) error {
+	if startHeight < 0 || startHeight > wal.multi.GetBestBlock().Height {
+		return ErrInvalidRescanHeight
+	}
+	for _, id := range walletIDs {
+		if wal.multi.WalletWithID(id) == nil {
+			return fmt.Errorf("wallet %d does not exist", id)
+		}
+	}
+
+	rq := &wal.rescanQueue
+	rq.mu.Lock()
+	for _, id := range walletIDs {
+		rq.pending = append(rq.pending, RescanRequest{WalletID: id, StartHeight: startHeight})
+	}
+	running := rq.current != nil
+	rq.mu.Unlock()
+
+	if running {
+		return nil
+	}
+	return rq.startNext()
+}
+
+// QueuedRescans returns the rescans waiting for the running one to end.
+func (wal *Wallet) QueuedRescans() []RescanRequest {
+	wal.rescanQueue.mu.Lock()
+	defer wal.rescanQueue.mu.Unlock()
+	return append([]RescanRequest(nil), wal.rescanQueue.pending...)
+}
+
+// CancelRescan stops the running rescan and drops the queued ones.
+func (wal *Wallet) CancelRescan() {
+	wal.rescanQueue.mu.Lock()
+	wal.rescanQueue.pending = nil
+	wal.rescanQueue.canceled = true
+	wal.rescanQueue.mu.Unlock()
+	wal.multi.CancelRescan()
+}
+
+// HeightAtTime estimates the height of the block mined at t, less a margin
+// so that a rescan from the height includes the transactions made at t.
+func (wal *Wallet) HeightAtTime(t time.Time) int32 {
+	best := wal.multi.GetBestBlock()
+	blockTime := time.Duration(wal.multi.TargetTimePerBlockMinutes() * float64(time.Minute))
+	elapsed := time.Unix(best.Timestamp, 0).Sub(t) + heightMarginFromDate
+
+	height := best.Height - int32(elapsed/blockTime)
+	switch {
+	case height < 0:
+		return 0
+	case height > best.Height:
+		return best.Height
+	}
+	return height
+}
+
+// EstimateRescanTime returns how long rescanning the given number of wallets
+// from startHeight takes at the speed of the previous rescans. It returns
+// false if no rescan has been measured yet.
+func (wal *Wallet) EstimateRescanTime(startHeight int32, wallets int) (time.Duration, bool) {
+	wal.rescanQueue.mu.Lock()
+	rate := wal.rescanQueue.rate
+	wal.rescanQueue.mu.Unlock()
+	if rate <= 0 {
+		return 0, false
+	}
+
+	blocks := wal.multi.GetBestBlock().Height - startHeight
+	if blocks < 0 {
+		blocks = 0
+	}
+	seconds := float64(blocks) * float64(wallets) / rate
+	return time.Duration(seconds * float64(time.Second)), true
+}
+
+// averageRescanRate returns the new average of the rescan rate after a rescan
+// at rate. Recent rescans count more as they ran on similar hardware.
+func averageRescanRate(average, rate float64) float64 {
+	if average <= 0 {
+		return rate
+	}
+	return average*0.7 + rate*0.3
+}
+
+// reset drops the rescans of the previously loaded wallets.
+func (rq *rescanQueue) reset(wal *Wallet) {
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	rq.wal = wal
+	rq.pending = nil
+	rq.current = nil
+	rq.rate = 0
+	wal.multi.ReadUserConfigValue(rescanRateConfigKey, &rq.rate)
+}
+
+// startNext starts the first queued rescan, skipping those that cannot
+// start.
+func (rq *rescanQueue) startNext() error {
+	var firstErr error
+	for {
+		rq.mu.Lock()
+		if len(rq.pending) == 0 {
+			rq.current = nil
+			rq.mu.Unlock()
+			return firstErr
+		}
+		req := rq.pending[0]
+		rq.pending = rq.pending[1:]
+		rq.current = &req
+		rq.started = time.Now()
+		rq.scanned = req.StartHeight
+		rq.canceled = false
+		rq.task = rq.wal.StartTask(TaskRescan, req.WalletID, rq.wal.CancelRescan)
+		task := rq.task
+		rq.mu.Unlock()
+
+		err := rq.wal.multi.RescanBlocksFromHeight(req.WalletID, req.StartHeight)
+		if err == nil {
+			return firstErr
+		}
+		task.End(err)
+		log.Errorf("Unable to rescan wallet %d from height %d: %v", req.WalletID, req.StartHeight, err)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+}
+
+// startNextWhenIdle starts the next queued rescan once dcrlibwallet has
+// cleaned up the previous one, which happens after the end notification.
+func (rq *rescanQueue) startNextWhenIdle() {
+	deadline := time.Now().Add(rescanStartTimeout)
+	for rq.wal.multi.IsRescanning() && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	rq.startNext()
+}
+
+func (rq *rescanQueue) listener() dcrlibwallet.BlocksRescanProgressListener {
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	return rq.next
+}
+
+func (rq *rescanQueue) OnBlocksRescanStarted(walletID int) {
+	setFlag(&rq.wal.crashState.rescanning, true)
+	if next := rq.listener(); next != nil {
+		next.OnBlocksRescanStarted(walletID)
+	}
+}
+
+func (rq *rescanQueue) OnBlocksRescanProgress(progress *dcrlibwallet.HeadersRescanProgressReport) {
+	rq.mu.Lock()
+	rq.scanned = progress.CurrentRescanHeight
+	task := rq.task
+	rq.mu.Unlock()
+
+	if task != nil {
+		task.Progress(int(progress.RescanProgress))
+	}
+
+	if next := rq.listener(); next != nil {
+		next.OnBlocksRescanProgress(progress)
+	}
+}
+
+func (rq *rescanQueue) OnBlocksRescanEnded(walletID int, err error) {
+	setFlag(&rq.wal.crashState.rescanning, false)
+	rq.mu.Lock()
+	current := rq.current
+	elapsed := time.Since(rq.started).Seconds()
+	var blocks int32
+	if current != nil {
+		blocks = rq.scanned - current.StartHeight
+	}
+
+	// Short rescans are dominated by the time spent indexing transactions
+	// and would skew the rate.
+	measured := err == nil && current != nil && current.WalletID == walletID && blocks > 0 && elapsed > 1
+	if measured {
+		rq.rate = averageRescanRate(rq.rate, float64(blocks)/elapsed)
+	}
+	rate := rq.rate
+
+	if rq.task != nil {
+		if rq.canceled {
+			rq.task.Canceled()
+		} else {
+			rq.task.End(err)
+		}
+		rq.task = nil
+	}
+	rq.mu.Unlock()
+
+	if measured {
+		rq.wal.multi.SaveUserConfigValue(rescanRateConfigKey, rate)
+	}
+
+	if next := rq.listener(); next != nil {
+		next.OnBlocksRescanEnded(walletID, err)
+	}
+
+	if current != nil {
+		go rq.startNextWhenIdle()
+	}
+}
@@ -0,0 +1,19 @@
+package wallet
+
+import "testing"
+
+func TestAverageRescanRate(t *testing.T) {
+	tests := []struct {
+		average, rate, want float64
+	}{
+		{0, 500, 500},
+		{1000, 1000, 1000},
+		{1000, 2000, 1300},
+	}
+
+	for _, test := range tests {
+		if got := averageRescanRate(test.average, test.rate); got != test.want {
+			t.Errorf("averageRescanRate(%v, %v) = %v, want %v", test.average, test.rate, got, test.want)
+		}
+	}
+}
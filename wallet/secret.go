@@ -0,0 +1,9 @@
+package wallet
+
+// ZeroBytes overwrites b with zeros so that secrets such as passphrases don't
+// linger in memory after use.
+func ZeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,238 @@
+package wallet
+
+import (
+	"crypto/sha256"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+// SeedWordCount is the number of words in a wallet seed mnemonic, including
+// the checksum word.
+const SeedWordCount = 33
+
+// SeedWordStatus describes the validity of a single seed word.
+type SeedWordStatus int
+
+const (
+	// SeedWordValid is a word from the PGP word list at a valid position.
+	SeedWordValid SeedWordStatus = iota
+	// SeedWordEmpty is a blank word.
+	SeedWordEmpty
+	// SeedWordUnknown is a word that is not in the PGP word list.
+	SeedWordUnknown
+	// SeedWordWrongPosition is a PGP word from the even list at an odd
+	// position or from the odd list at an even position.
+	SeedWordWrongPosition
+)
+
+// SeedCorrection is a candidate fix for a seed that fails its checksum.
+type SeedCorrection struct {
+	Words []string
+	// Positions are the zero based positions of the changed words. Two
+	// positions mean the words at those positions were swapped.
+	Positions []int
+
+	distance int
+}
+
+// pgpWords maps every lower case PGP word to its index in the word list. Even
+// indexes are the words used at even seed positions and odd indexes the words
+// used at odd seed positions; index/2 is the byte the word encodes.
+var pgpWordList, pgpWords = func() ([]string, map[string]int) {
+	list := dcrlibwallet.PGPWordList()
+	words := make(map[string]int, len(list))
+	for i, word := range list {
+		words[strings.ToLower(word)] = i
+	}
+	return list, words
+}()
+
+// ParseSeedInput splits a pasted seed into its words. Words may be separated
+// by whitespace, commas or semicolons, and may be numbered such as "1. word".
+func ParseSeedInput(input string) []string {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == ';'
+	})
+
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimFunc(field, func(r rune) bool {
+			return unicode.IsDigit(r) || unicode.IsPunct(r)
+		})
+		if field != "" {
+			words = append(words, field)
+		}
+	}
+	return words
+}
+
+// SeedWordAt returns the status of word used at the zero based position of a
+// seed.
+func SeedWordAt(word string, position int) SeedWordStatus {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if word == "" {
+		return SeedWordEmpty
+	}
+
+	index, ok := pgpWords[word]
+	if !ok {
+		return SeedWordUnknown
+	}
+	if index%2 != position%2 {
+		return SeedWordWrongPosition
+	}
+	return SeedWordValid
+}
+
+// SeedWordsForPosition returns the PGP words that may be used at the zero
+// based position of a seed.
+func SeedWordsForPosition(position int) []string {
+	words := make([]string, 0, len(pgpWordList)/2)
+	for i := position % 2; i < len(pgpWordList); i += 2 {
+		words = append(words, pgpWordList[i])
+	}
+	return words
+}
+
+// SeedChecksumValid returns true if words form a seed with a valid checksum.
+func SeedChecksumValid(words []string) bool {
+	data, ok := seedWordsToBytes(words)
+	if !ok || len(data) < 2 {
+		return false
+	}
+	return seedChecksum(data[:len(data)-1]) == data[len(data)-1]
+}
+
+// SeedCorrections returns up to maxCandidates corrections for a seed that fails
+// its checksum. Single word substitutions at every position and swaps of
+// adjacent words are tried. Substitutions that are closer to the entered word
+// are returned first.
+func SeedCorrections(words []string, maxCandidates int) []SeedCorrection {
+	if len(words) != SeedWordCount || SeedChecksumValid(words) {
+		return nil
+	}
+
+	data := make([]byte, len(words))
+	var invalid []int
+	for i, word := range words {
+		if SeedWordAt(word, i) != SeedWordValid {
+			invalid = append(invalid, i)
+			continue
+		}
+		data[i] = byte(pgpWords[strings.ToLower(word)] / 2)
+	}
+
+	var candidates []SeedCorrection
+
+	// Swapping two adjacent words always leaves both words at the wrong
+	// position, so swaps only need to be tried for such pairs.
+	for i := 0; i+1 < len(words); i++ {
+		if SeedWordAt(words[i], i) != SeedWordWrongPosition || SeedWordAt(words[i+1], i+1) != SeedWordWrongPosition {
+			continue
+		}
+		swapped := append([]string{}, words...)
+		swapped[i], swapped[i+1] = swapped[i+1], swapped[i]
+		if SeedChecksumValid(swapped) {
+			candidates = append(candidates, SeedCorrection{
+				Words:     swapped,
+				Positions: []int{i, i + 1},
+			})
+		}
+	}
+
+	// More than one invalid word cannot be fixed with a single substitution.
+	positions := invalid
+	switch {
+	case len(invalid) > 1:
+		positions = nil
+	case len(invalid) == 0:
+		for i := range words {
+			positions = append(positions, i)
+		}
+	}
+
+	for _, position := range positions {
+		original := data[position]
+		for b := 0; b < 256; b++ {
+			data[position] = byte(b)
+			if seedChecksum(data[:len(data)-1]) != data[len(data)-1] {
+				continue
+			}
+
+			replacement := pgpWordList[b*2+position%2]
+			if strings.EqualFold(replacement, words[position]) {
+				continue
+			}
+
+			corrected := append([]string{}, words...)
+			corrected[position] = replacement
+			candidates = append(candidates, SeedCorrection{
+				Words:     corrected,
+				Positions: []int{position},
+				distance:  editDistance(strings.ToLower(words[position]), strings.ToLower(replacement)),
+			})
+		}
+		data[position] = original
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
+	return candidates
+}
+
+func seedWordsToBytes(words []string) ([]byte, bool) {
+	data := make([]byte, len(words))
+	for i, word := range words {
+		if SeedWordAt(word, i) != SeedWordValid {
+			return nil, false
+		}
+		data[i] = byte(pgpWords[strings.ToLower(word)] / 2)
+	}
+	return data, true
+}
+
+// seedChecksum returns the checksum byte of seed data, the first byte of its
+// double sha256 hash.
+func seedChecksum(data []byte) byte {
+	intermediateHash := sha256.Sum256(data)
+	return sha256.Sum256(intermediateHash[:])[0]
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,49 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/v2/walletseed"
+)
+
+func TestSeedCorrections(t *testing.T) {
+	seed, err := walletseed.GenerateRandomSeed(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := strings.Fields(walletseed.EncodeMnemonic(seed))
+	if !SeedChecksumValid(words) {
+		t.Fatal("generated seed has an invalid checksum")
+	}
+
+	hasCorrection := func(candidates []SeedCorrection) bool {
+		for _, candidate := range candidates {
+			if strings.Join(candidate.Words, " ") == strings.Join(words, " ") {
+				return true
+			}
+		}
+		return false
+	}
+
+	// A misspelt word.
+	typo := append([]string{}, words...)
+	typo[5] = typo[5][:len(typo[5])-1]
+	if !hasCorrection(SeedCorrections(typo, 10)) {
+		t.Fatal("misspelt word was not corrected")
+	}
+
+	// Two swapped words.
+	swapped := append([]string{}, words...)
+	swapped[10], swapped[11] = swapped[11], swapped[10]
+	if !hasCorrection(SeedCorrections(swapped, 10)) {
+		t.Fatal("swapped words were not corrected")
+	}
+}
+
+func TestParseSeedInput(t *testing.T) {
+	words := ParseSeedInput("1. aardvark, absurd;\n3) accrue\tacme")
+	if strings.Join(words, " ") != "aardvark absurd accrue acme" {
+		t.Fatalf("unexpected words %v", words)
+	}
+}
@@ -0,0 +1,256 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+
+	"decred.org/dcrwallet/v2/walletseed"
+)
+
+const (
+	// seedShareVersion is the version of the seed share encoding. It is the
+	// first byte of every share.
+	seedShareVersion = 1
+
+	// seedShareHeaderSize is the number of bytes that precede the share data:
+	// version, 2 byte split identifier, threshold and share index.
+	seedShareHeaderSize = 5
+
+	// MaxSeedShares is the maximum number of shares a seed can be split into.
+	MaxSeedShares = 16
+)
+
+var (
+	// ErrInvalidSeedShare is returned when a share cannot be decoded.
+	ErrInvalidSeedShare = errors.New("invalid seed share")
+
+	// ErrMismatchedSeedShares is returned when shares from different seed
+	// splits are combined.
+	ErrMismatchedSeedShares = errors.New("seed shares are not from the same backup")
+
+	// ErrNotEnoughSeedShares is returned when fewer shares than the split
+	// threshold are combined.
+	ErrNotEnoughSeedShares = errors.New("not enough seed shares")
+)
+
+// SeedShare is one part of a seed split with SplitSeed. Any Threshold shares
+// of the same split can be combined to recover the seed.
+type SeedShare struct {
+	Index     int
+	Threshold int
+	Words     []string
+}
+
+// Checksum returns the checksum word of the share, which is always its last
+// word.
+func (share *SeedShare) Checksum() string {
+	return share.Words[len(share.Words)-1]
+}
+
+// SplitSeed splits the seed mnemonic into total shares using Shamir's secret
+// sharing over GF(256) such that any threshold shares recover the seed. Each
+// share is encoded as a PGP word list with a trailing checksum word.
+func SplitSeed(seedMnemonic string, threshold, total int) ([]*SeedShare, error) {
+	if threshold < 2 || threshold > total || total > MaxSeedShares {
+		return nil, fmt.Errorf("invalid share threshold %d of %d", threshold, total)
+	}
+
+	seed, err := walletseed.DecodeUserInput(normalizeSeedWords(seedMnemonic))
+	if err != nil {
+		return nil, err
+	}
+
+	var splitID [2]byte
+	if _, err := rand.Read(splitID[:]); err != nil {
+		return nil, err
+	}
+
+	payloads := make([][]byte, total)
+	for i := range payloads {
+		payloads[i] = make([]byte, seedShareHeaderSize+len(seed))
+		payloads[i][0] = seedShareVersion
+		copy(payloads[i][1:3], splitID[:])
+		payloads[i][3] = byte(threshold)
+		payloads[i][4] = byte(i + 1)
+	}
+
+	// Every seed byte is the constant term of its own random polynomial of
+	// degree threshold-1. Share i holds the polynomials evaluated at x = i+1.
+	coefficients := make([]byte, threshold)
+	for b, secret := range seed {
+		coefficients[0] = secret
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for i := range payloads {
+			payloads[i][seedShareHeaderSize+b] = gfEvaluate(coefficients, byte(i+1))
+		}
+	}
+	ZeroBytes(coefficients)
+	ZeroBytes(seed)
+
+	shares := make([]*SeedShare, total)
+	for i, payload := range payloads {
+		shares[i] = &SeedShare{
+			Index:     i + 1,
+			Threshold: threshold,
+			Words:     walletseed.EncodeMnemonicSlice(payload),
+		}
+		ZeroBytes(payload)
+	}
+	return shares, nil
+}
+
+// DecodeSeedShare decodes a share from its word list and verifies its
+// checksum.
+func DecodeSeedShare(words string) (*SeedShare, error) {
+	_, share, err := decodeSeedShare(words)
+	return share, err
+}
+
+// CombineSeedShares recovers the seed mnemonic from at least threshold shares
+// of the same split.
+func CombineSeedShares(shareWords []string) (string, error) {
+	var (
+		splitID   []byte
+		threshold int
+		xs        []byte
+		ys        [][]byte
+	)
+
+	for _, words := range shareWords {
+		payload, share, err := decodeSeedShare(words)
+		if err != nil {
+			return "", err
+		}
+
+		if splitID == nil {
+			splitID, threshold = payload[1:3], share.Threshold
+		} else if string(splitID) != string(payload[1:3]) || threshold != share.Threshold {
+			return "", ErrMismatchedSeedShares
+		}
+
+		duplicate := false
+		for _, x := range xs {
+			duplicate = duplicate || x == byte(share.Index)
+		}
+		if duplicate {
+			continue
+		}
+
+		xs = append(xs, byte(share.Index))
+		ys = append(ys, payload[seedShareHeaderSize:])
+	}
+
+	if len(xs) < threshold || len(xs) == 0 {
+		return "", ErrNotEnoughSeedShares
+	}
+
+	xs, ys = xs[:threshold], ys[:threshold]
+	seed := make([]byte, len(ys[0]))
+	for b := range seed {
+		seed[b] = gfInterpolateAtZero(xs, ys, b)
+	}
+	defer ZeroBytes(seed)
+
+	return walletseed.EncodeMnemonic(seed), nil
+}
+
+func decodeSeedShare(words string) ([]byte, *SeedShare, error) {
+	normalized := normalizeSeedWords(words)
+	payload, err := walletseed.DecodeUserInput(normalized)
+	if err != nil || len(payload) <= seedShareHeaderSize || payload[0] != seedShareVersion {
+		return nil, nil, ErrInvalidSeedShare
+	}
+
+	share := &SeedShare{
+		Index:     int(payload[4]),
+		Threshold: int(payload[3]),
+		Words:     strings.Split(normalized, " "),
+	}
+	if share.Index == 0 || share.Threshold < 2 {
+		return nil, nil, ErrInvalidSeedShare
+	}
+	return payload, share, nil
+}
+
+// normalizeSeedWords joins words separated by any whitespace with a single
+// space as expected by walletseed.DecodeUserInput.
+func normalizeSeedWords(words string) string {
+	return strings.Join(strings.Fields(words), " ")
+}
+
+// GF(256) arithmetic using the AES reduction polynomial x^8+x^4+x^3+x+1.
+var gfExp, gfLog = func() (exp [510]byte, log [256]byte) {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		log[x] = byte(i)
+		// Multiply x by the generator 3.
+		x ^= gfMulNoTable(x, 2)
+	}
+	for i := 255; i < len(exp); i++ {
+		exp[i] = exp[i-255]
+	}
+	return
+}()
+
+func gfMulNoTable(a, b byte) byte {
+	var p byte
+	for b > 0 {
+		if b&1 != 0 {
+			p ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// gfEvaluate evaluates the polynomial with the given coefficients at x.
+func gfEvaluate(coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = gfMul(y, x) ^ coefficients[i]
+	}
+	return y
+}
+
+// gfInterpolateAtZero returns the constant term of the polynomial through the
+// points (xs[i], ys[i][b]) using Lagrange interpolation.
+func gfInterpolateAtZero(xs []byte, ys [][]byte, b int) byte {
+	var secret byte
+	for i, xi := range xs {
+		basis := byte(1)
+		for j, xj := range xs {
+			if i == j {
+				continue
+			}
+			// In GF(2^n) subtraction is xor, so (0 - xj) / (xi - xj) is
+			// xj / (xi ^ xj).
+			basis = gfMul(basis, gfDiv(xj, xi^xj))
+		}
+		secret ^= gfMul(ys[i][b], basis)
+	}
+	return secret
+}
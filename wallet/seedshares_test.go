@@ -0,0 +1,64 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/v2/walletseed"
+)
+
+func TestSplitAndCombineSeed(t *testing.T) {
+	seedBytes, err := walletseed.GenerateRandomSeed(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed := walletseed.EncodeMnemonic(seedBytes)
+
+	shares, err := SplitSeed(seed, 3, 5)
+	if err != nil {
+		t.Fatalf("split: %v", err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("expected 5 shares, got %d", len(shares))
+	}
+
+	join := func(indexes ...int) []string {
+		var words []string
+		for _, i := range indexes {
+			words = append(words, strings.Join(shares[i].Words, " "))
+		}
+		return words
+	}
+
+	for _, combination := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		recovered, err := CombineSeedShares(join(combination...))
+		if err != nil {
+			t.Fatalf("combine %v: %v", combination, err)
+		}
+		if recovered != seed {
+			t.Fatalf("combine %v: recovered seed does not match", combination)
+		}
+	}
+
+	if _, err := CombineSeedShares(join(0, 1)); err != ErrNotEnoughSeedShares {
+		t.Fatalf("expected ErrNotEnoughSeedShares, got %v", err)
+	}
+	if _, err := CombineSeedShares(join(0, 0, 1)); err != ErrNotEnoughSeedShares {
+		t.Fatalf("expected duplicate shares to be ignored, got %v", err)
+	}
+
+	other, err := SplitSeed(seed, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mixed := append(join(0, 1), strings.Join(other[2].Words, " "))
+	if _, err := CombineSeedShares(mixed); err != ErrMismatchedSeedShares {
+		t.Fatalf("expected ErrMismatchedSeedShares, got %v", err)
+	}
+
+	tampered := append([]string{}, shares[0].Words...)
+	tampered[5], tampered[7] = tampered[7], tampered[5]
+	if _, err := DecodeSeedShare(strings.Join(tampered, "\n")); err == nil {
+		t.Fatal("expected a tampered share to fail its checksum")
+	}
+}
@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+// sessionFileName is the file in the app data directory that exists while
+// the app runs. It is left behind when the app does not shut down cleanly.
+const sessionFileName = "session.json"
+
+// Session is a run of the app.
+type Session struct {
+	PID     int       `json:"pid"`
+	Started time.Time `json:"started"`
+	Version string    `json:"version"`
+}
+
+// StartSession records that the app is running. It returns the previous
+// session if that one did not shut down cleanly.
+func (wal *Wallet) StartSession() (*Session, error) {
+	path := filepath.Join(wal.homeDir, sessionFileName)
+
+	var previous *Session
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		previous = new(Session)
+		if err = json.Unmarshal(data, previous); err != nil {
+			// The file was left by a run that crashed while
+			// writing it.
+			previous = &Session{}
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+	wal.uncleanSession = previous
+
+	data, err = json.Marshal(Session{PID: os.Getpid(), Started: wal.startUpTime, Version: wal.version})
+	if err != nil {
+		return previous, err
+	}
+	return previous, os.WriteFile(path, data, 0600)
+}
+
+// EndSession records that the app shut down cleanly.
+func (wal *Wallet) EndSession() error {
+	err := os.Remove(filepath.Join(wal.homeDir, sessionFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// UncleanShutdown returns the previous session if it did not shut down
+// cleanly and has not been reported with DismissUncleanShutdown.
+func (wal *Wallet) UncleanShutdown() *Session {
+	return wal.uncleanSession
+}
+
+// DismissUncleanShutdown marks the unclean shutdown as reported.
+func (wal *Wallet) DismissUncleanShutdown() {
+	wal.uncleanSession = nil
+}
+
+// isCriticalTask returns true if quitting while a task of kind runs can
+// leave the wallets in an incomplete state. dcrlibwallet does not tell when
+// a ticket purchase or VSP fee payment of the ticket buyer ends, so a running
+// ticket buyer is critical for as long as it runs.
+func isCriticalTask(kind TaskKind) bool {
+	switch kind {
+	case TaskBroadcast, TaskRescan, TaskAccountMixer, TaskTicketBuyer:
+		return true
+	}
+	return false
+}
+
+// CriticalTasks returns the running tasks that must end or be stopped before
+// the app quits.
+func (wal *Wallet) CriticalTasks() []TaskInfo {
+	return wal.runningTasks(isCriticalTask)
+}
+
+// TicketBuyerTasks returns the running ticket buyers. They are critical
+// tasks that only end when they are stopped.
+func (wal *Wallet) TicketBuyerTasks() []TaskInfo {
+	return wal.runningTasks(func(kind TaskKind) bool {
+		return kind == TaskTicketBuyer
+	})
+}
+
+func (wal *Wallet) runningTasks(match func(TaskKind) bool) []TaskInfo {
+	var tasks []TaskInfo
+	for _, task := range wal.tasks.list() {
+		if task.State == TaskRunning && match(task.Kind) {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+// CheckpointTasks stops the critical tasks that can be canceled, so they end
+// through their own cancel path rather than when the wallets are closed. A
+// canceled rescan can be run again. Broadcasts cannot be canceled and are
+// left to finish.
+func (wal *Wallet) CheckpointTasks() {
+	for _, task := range wal.CriticalTasks() {
+		if task.Cancelable {
+			log.Infof("Stopping task %d for shutdown", task.ID)
+			wal.CancelTask(task.ID)
+		}
+	}
+}
+
+// Broadcast signs and broadcasts the transaction of tx, a spend from the
+// wallet with walletID, and registers it as a task so the app does not quit
+// while it is sent.
+func (wal *Wallet) Broadcast(walletID int, tx *dcrlibwallet.TxAuthor, passphrase []byte) ([]byte, error) {
+	task := wal.StartTask(TaskBroadcast, walletID, nil)
+	hash, err := tx.Broadcast(passphrase)
+	task.End(err)
+	return hash, err
+}
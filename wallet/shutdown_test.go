@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+func TestSession(t *testing.T) {
+	homeDir := t.TempDir()
+	wal, err := NewWallet(homeDir, dcrlibwallet.Mainnet, "test", "", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previous, err := wal.StartSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if previous != nil {
+		t.Fatalf("first session reported an unclean shutdown: %+v", previous)
+	}
+
+	// A second run without EndSession finds the first session.
+	next, err := NewWallet(homeDir, dcrlibwallet.Mainnet, "test", "", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous, err = next.StartSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if previous == nil || !previous.Started.Equal(wal.startUpTime) {
+		t.Fatalf("unclean session: got %+v, want start time %v", previous, wal.startUpTime)
+	}
+	if next.UncleanShutdown() == nil {
+		t.Fatal("unclean shutdown not kept")
+	}
+	next.DismissUncleanShutdown()
+	if next.UncleanShutdown() != nil {
+		t.Fatal("unclean shutdown not dismissed")
+	}
+
+	if err = next.EndSession(); err != nil {
+		t.Fatal(err)
+	}
+	if previous, err = next.StartSession(); err != nil || previous != nil {
+		t.Fatalf("session after a clean shutdown: got %+v, %v", previous, err)
+	}
+}
+
+func TestCriticalTasks(t *testing.T) {
+	wal := &Wallet{}
+	canceled := false
+	rescan := wal.StartTask(TaskRescan, 1, func() { canceled = true })
+	wal.StartTask(TaskExchangeRate, 0, nil)
+	broadcast := wal.StartTask(TaskBroadcast, 2, nil)
+	ticketBuyerStopped := false
+	wal.tasks.start(TaskTicketBuyer, 1, func() { ticketBuyerStopped = true }, func() bool { return !ticketBuyerStopped })
+
+	if n := len(wal.CriticalTasks()); n != 3 {
+		t.Fatalf("critical tasks: got %d, want 3", n)
+	}
+	if n := len(wal.TicketBuyerTasks()); n != 1 {
+		t.Fatalf("ticket buyers: got %d, want 1", n)
+	}
+
+	wal.CheckpointTasks()
+	if !canceled {
+		t.Fatal("cancelable critical task not canceled")
+	}
+	if !ticketBuyerStopped {
+		t.Fatal("ticket buyer not stopped for shutdown")
+	}
+	rescan.Canceled()
+	broadcast.End(nil)
+	if tasks := wal.CriticalTasks(); len(tasks) != 0 {
+		t.Fatalf("critical tasks after they ended: %+v", tasks)
+	}
+}
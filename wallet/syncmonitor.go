@@ -0,0 +1,266 @@
+package wallet
+
+import (
+	"sync"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+const (
+	syncFailuresConfigKey = "sync_failures"
+	maxSyncFailures       = 50
+
+	minSyncRetryDelay = 5 * time.Second
+	maxSyncRetryDelay = 10 * time.Minute
+)
+
+// SyncFailure is a sync that stopped because of an error.
+type SyncFailure struct {
+	Time  time.Time `json:"time"`
+	Error string    `json:"error"`
+
+	// Attempt is the number of failed syncs in a row, including this one.
+	Attempt int `json:"attempt"`
+}
+
+// SyncHealth describes the state of the wallet sync beyond its progress.
+type SyncHealth struct {
+	// LastProgress is when the sync last reported progress or a peer
+	// change. It is zero if the sync has not started.
+	LastProgress time.Time
+
+	// LastError is the error that ended the last sync, if the wallets have
+	// not synced since.
+	LastError string
+
+	// Failures is the number of failed syncs in a row.
+	Failures int
+
+	// NextRetry is when the sync is restarted after the last failure. It is
+	// zero if no restart is scheduled.
+	NextRetry time.Time
+
+	// Paused is true if the sync was stopped or refused by the sync policy
+	// and starts again when the policy allows it.
+	Paused bool
+}
+
+// syncMonitor restarts the sync with exponential backoff when it ends with an
+// error and keeps the history of sync failures and peer connections. It
+// satisfies the dcrlibwallet.SyncProgressListener interface.
+type syncMonitor struct {
+	wal *Wallet
+
+	mu         sync.Mutex
+	health     SyncHealth
+	retryTimer *time.Timer
+
+	// schedulerQuit stops the sync policy checks.
+	schedulerQuit chan struct{}
+
+	// task is the registered task of the running sync.
+	task *Task
+
+	peers peerLog
+}
+
+// SyncHealth returns the state of the wallet sync beyond its progress.
+func (wal *Wallet) SyncHealth() SyncHealth {
+	wal.syncMonitor.mu.Lock()
+	defer wal.syncMonitor.mu.Unlock()
+	return wal.syncMonitor.health
+}
+
+// SyncFailures returns the recorded sync failures, oldest first.
+func (wal *Wallet) SyncFailures() []SyncFailure {
+	var failures []SyncFailure
+	if wal.multi != nil {
+		wal.multi.ReadUserConfigValue(syncFailuresConfigKey, &failures)
+	}
+	return failures
+}
+
+// ClearSyncFailures removes the recorded sync failures.
+func (wal *Wallet) ClearSyncFailures() {
+	wal.multi.DeleteUserConfigValueForKey(syncFailuresConfigKey)
+}
+
+// RestartSync restarts a stalled sync unless the sync is turned off by
+// offline or Tor only mode.
+func (wal *Wallet) RestartSync() error {
+	if err := wal.FeatureError(FeatureSync); err != nil {
+		return err
+	}
+	return wal.multi.RestartSpvSync()
+}
+
+// syncRetryDelay returns how long to wait before restarting the sync after
+// failures failed syncs in a row.
+func syncRetryDelay(failures int) time.Duration {
+	delay := minSyncRetryDelay
+	for i := 1; i < failures && delay < maxSyncRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxSyncRetryDelay {
+		delay = maxSyncRetryDelay
+	}
+	return delay
+}
+
+// reset clears the sync state of the previously loaded wallets.
+func (sm *syncMonitor) reset(wal *Wallet) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.stopRetry()
+	sm.stopScheduler()
+	sm.wal = wal
+	sm.health = SyncHealth{}
+
+	sm.peers.mu.Lock()
+	sm.peers.events = nil
+	sm.peers.known = nil
+	sm.peers.mu.Unlock()
+}
+
+func (sm *syncMonitor) progressed(progress *dcrlibwallet.GeneralSyncProgress) {
+	sm.mu.Lock()
+	sm.health.LastProgress = time.Now()
+	task := sm.task
+	sm.mu.Unlock()
+
+	if task != nil && progress != nil {
+		task.Progress(int(progress.TotalSyncProgress))
+	}
+}
+
+// endTask ends the task of the running sync with end. sm.mu must be held.
+func (sm *syncMonitor) endTask(end func(*Task)) {
+	if sm.task != nil {
+		end(sm.task)
+		sm.task = nil
+	}
+}
+
+// stopRetry cancels a scheduled restart. sm.mu must be held.
+func (sm *syncMonitor) stopRetry() {
+	if sm.retryTimer != nil {
+		sm.retryTimer.Stop()
+		sm.retryTimer = nil
+	}
+	sm.health.NextRetry = time.Time{}
+}
+
+func (sm *syncMonitor) retry() {
+	sm.mu.Lock()
+	sm.retryTimer = nil
+	sm.health.NextRetry = time.Time{}
+	sm.mu.Unlock()
+
+	if sm.wal.multi.IsSyncing() || sm.wal.multi.IsSynced() {
+		return
+	}
+	if err := sm.wal.SpvSync(); err != nil && !isSyncPolicyError(err) {
+		log.Errorf("Unable to restart sync: %v", err)
+	}
+}
+
+// setCrashState records the sync state for the crash handler.
+func (sm *syncMonitor) setCrashState(syncing, synced bool) {
+	setFlag(&sm.wal.crashState.syncing, syncing)
+	setFlag(&sm.wal.crashState.synced, synced)
+}
+
+func (sm *syncMonitor) OnSyncStarted(wasRestarted bool) {
+	sm.setCrashState(true, false)
+	sm.peers.record(SyncStartedEvent, nil)
+
+	sm.mu.Lock()
+	sm.stopRetry()
+	sm.health.LastProgress = time.Now()
+	sm.health.Paused = false
+	sm.endTask((*Task).Canceled)
+	multi := sm.wal.multi
+	sm.task = sm.wal.StartTask(TaskSync, 0, func() { go multi.CancelSync() })
+	sm.mu.Unlock()
+}
+
+func (sm *syncMonitor) OnPeerConnectedOrDisconnected(numberOfConnectedPeers int32) {
+	sm.progressed(nil)
+	sm.peers.update(sm.wal.ConnectedPeers())
+}
+
+func (sm *syncMonitor) OnCFiltersFetchProgress(report *dcrlibwallet.CFiltersFetchProgressReport) {
+	sm.progressed(report.GeneralSyncProgress)
+}
+
+func (sm *syncMonitor) OnHeadersFetchProgress(report *dcrlibwallet.HeadersFetchProgressReport) {
+	sm.progressed(report.GeneralSyncProgress)
+}
+
+func (sm *syncMonitor) OnAddressDiscoveryProgress(report *dcrlibwallet.AddressDiscoveryProgressReport) {
+	sm.progressed(report.GeneralSyncProgress)
+}
+
+func (sm *syncMonitor) OnHeadersRescanProgress(report *dcrlibwallet.HeadersRescanProgressReport) {
+	sm.progressed(report.GeneralSyncProgress)
+}
+
+func (sm *syncMonitor) OnSyncCompleted() {
+	sm.setCrashState(false, true)
+	sm.mu.Lock()
+	sm.stopRetry()
+	sm.health.LastProgress = time.Now()
+	sm.health.LastError = ""
+	sm.health.Failures = 0
+	sm.endTask(func(t *Task) { t.End(nil) })
+	sm.mu.Unlock()
+
+	if sm.wal.SyncPolicy().Mode == SyncOnce {
+		log.Info("Wallets synced, disconnecting as set by the sync policy")
+		// CancelSync waits for the sync to end, which cannot happen while
+		// this listener runs.
+		go sm.wal.multi.CancelSync()
+	}
+}
+
+func (sm *syncMonitor) OnSyncCanceled(willRestart bool) {
+	sm.setCrashState(false, false)
+	sm.peers.update(nil)
+	sm.peers.record(SyncStoppedEvent, nil)
+
+	sm.mu.Lock()
+	sm.stopRetry()
+	sm.health.LastProgress = time.Time{}
+	sm.endTask((*Task).Canceled)
+	sm.mu.Unlock()
+}
+
+func (sm *syncMonitor) OnSyncEndedWithError(err error) {
+	sm.setCrashState(false, false)
+	sm.peers.update(nil)
+	sm.peers.record(SyncFailedEvent, err)
+
+	sm.mu.Lock()
+	sm.stopRetry()
+	sm.health.LastProgress = time.Time{}
+	sm.health.LastError = err.Error()
+	sm.health.Failures++
+	sm.endTask(func(t *Task) { t.End(err) })
+	failure := SyncFailure{Time: time.Now(), Error: err.Error(), Attempt: sm.health.Failures}
+
+	delay := syncRetryDelay(sm.health.Failures)
+	sm.health.NextRetry = time.Now().Add(delay)
+	sm.retryTimer = time.AfterFunc(delay, sm.retry)
+	sm.mu.Unlock()
+
+	log.Errorf("Sync ended with error (attempt %d), restarting in %v: %v", failure.Attempt, delay, err)
+
+	failures := append(sm.wal.SyncFailures(), failure)
+	if len(failures) > maxSyncFailures {
+		failures = failures[len(failures)-maxSyncFailures:]
+	}
+	sm.wal.multi.SaveUserConfigValue(syncFailuresConfigKey, failures)
+}
+
+func (sm *syncMonitor) Debug(*dcrlibwallet.DebugInfo) {}
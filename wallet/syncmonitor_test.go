@@ -0,0 +1,26 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyncRetryDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{1, minSyncRetryDelay},
+		{2, 2 * minSyncRetryDelay},
+		{4, 8 * minSyncRetryDelay},
+		{7, 320 * time.Second},
+		{8, maxSyncRetryDelay},
+		{100, maxSyncRetryDelay},
+	}
+
+	for _, test := range tests {
+		if got := syncRetryDelay(test.failures); got != test.want {
+			t.Errorf("syncRetryDelay(%d) = %v, want %v", test.failures, got, test.want)
+		}
+	}
+}
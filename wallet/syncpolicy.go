@@ -0,0 +1,191 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	syncPolicyConfigKey = "sync_policy"
+
+	// syncPolicyCheckInterval is how often the sync is paused or resumed
+	// to follow the sync policy.
+	syncPolicyCheckInterval = time.Minute
+)
+
+var (
+	// ErrMeteredConnection is returned when the sync is started while the
+	// connection is marked as metered.
+	ErrMeteredConnection = errors.New("the connection is metered")
+
+	// ErrOutsideSyncHours is returned when the sync is started outside the
+	// hours allowed by the sync policy.
+	ErrOutsideSyncHours = errors.New("outside of the sync hours")
+)
+
+// SyncMode is when the wallets sync.
+type SyncMode int
+
+const (
+	// SyncContinuous keeps the wallets synced while the app runs.
+	SyncContinuous SyncMode = iota
+
+	// SyncScheduled syncs only between the start and end hours of the
+	// policy.
+	SyncScheduled
+
+	// SyncOnce disconnects from the network once the wallets are synced.
+	SyncOnce
+)
+
+// SyncPolicy limits when the wallets sync to save bandwidth.
+type SyncPolicy struct {
+	Mode SyncMode `json:"mode"`
+
+	// StartHour and EndHour are the hours of the day, in local time, that
+	// the sync is allowed between in SyncScheduled mode. The window wraps
+	// around midnight when EndHour is not after StartHour.
+	StartHour int `json:"start_hour"`
+	EndHour   int `json:"end_hour"`
+
+	// Metered pauses the sync until the connection is no longer metered.
+	Metered bool `json:"metered"`
+}
+
+func (p SyncPolicy) validate() error {
+	switch p.Mode {
+	case SyncContinuous, SyncOnce:
+		return nil
+	case SyncScheduled:
+	default:
+		return fmt.Errorf("unknown sync mode %d", p.Mode)
+	}
+
+	if p.StartHour < 0 || p.StartHour > 23 || p.EndHour < 0 || p.EndHour > 23 {
+		return errors.New("sync hours must be between 0 and 23")
+	}
+	if p.StartHour == p.EndHour {
+		return errors.New("the sync hours must not start and end at the same hour")
+	}
+	return nil
+}
+
+// inWindow returns true if t is within the sync hours.
+func (p SyncPolicy) inWindow(t time.Time) bool {
+	if p.Mode != SyncScheduled {
+		return true
+	}
+
+	hour := t.Hour()
+	if p.StartHour < p.EndHour {
+		return hour >= p.StartHour && hour < p.EndHour
+	}
+	return hour >= p.StartHour || hour < p.EndHour
+}
+
+// err returns the reason the policy does not allow syncing at t.
+func (p SyncPolicy) err(t time.Time) error {
+	if p.Metered {
+		return ErrMeteredConnection
+	}
+	if !p.inWindow(t) {
+		return ErrOutsideSyncHours
+	}
+	return nil
+}
+
+// SyncPolicy returns the saved sync policy.
+func (wal *Wallet) SyncPolicy() SyncPolicy {
+	var p SyncPolicy
+	if wal.multi != nil {
+		wal.multi.ReadUserConfigValue(syncPolicyConfigKey, &p)
+	}
+	return p
+}
+
+// SaveSyncPolicy saves the sync policy and pauses or resumes the sync to
+// follow it.
+func (wal *Wallet) SaveSyncPolicy(p SyncPolicy) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	wal.multi.SaveUserConfigValue(syncPolicyConfigKey, p)
+	wal.cacheCrashConfig()
+	go wal.syncMonitor.applyPolicy()
+	return nil
+}
+
+func isSyncPolicyError(err error) bool {
+	return errors.Is(err, ErrMeteredConnection) || errors.Is(err, ErrOutsideSyncHours)
+}
+
+// applyPolicy stops the sync when the policy no longer allows it and starts
+// it again when the policy allows it, if it was stopped by the policy.
+func (sm *syncMonitor) applyPolicy() {
+	if sm.wal == nil || sm.wal.multi == nil {
+		return
+	}
+
+	err := sm.wal.SyncPolicy().err(time.Now())
+	connected := sm.wal.multi.IsConnectedToDecredNetwork()
+
+	sm.mu.Lock()
+	paused := sm.health.Paused
+	if err != nil && connected {
+		sm.health.Paused = true
+		sm.stopRetry()
+	}
+	sm.mu.Unlock()
+
+	switch {
+	case err != nil && connected:
+		log.Infof("Pausing sync: %v", err)
+		sm.wal.multi.CancelSync()
+	case err == nil && paused && !connected:
+		log.Info("Resuming sync")
+		sm.setPaused(false)
+		if err := sm.wal.SpvSync(); err != nil {
+			log.Errorf("Unable to resume sync: %v", err)
+		}
+	}
+}
+
+func (sm *syncMonitor) setPaused(paused bool) {
+	sm.mu.Lock()
+	sm.health.Paused = paused
+	sm.mu.Unlock()
+}
+
+// startScheduler checks the sync policy every syncPolicyCheckInterval until
+// stopScheduler is called.
+func (sm *syncMonitor) startScheduler() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	if sm.schedulerQuit != nil {
+		return
+	}
+
+	quit := make(chan struct{})
+	sm.schedulerQuit = quit
+	go func() {
+		ticker := time.NewTicker(syncPolicyCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				sm.applyPolicy()
+			case <-quit:
+				return
+			}
+		}
+	}()
+}
+
+// stopScheduler stops the policy checks. sm.mu must be held.
+func (sm *syncMonitor) stopScheduler() {
+	if sm.schedulerQuit != nil {
+		close(sm.schedulerQuit)
+		sm.schedulerQuit = nil
+	}
+}
@@ -0,0 +1,50 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyncPolicyInWindow(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2022, 8, 1, hour, 30, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		policy SyncPolicy
+		hour   int
+		want   bool
+	}{
+		{SyncPolicy{Mode: SyncContinuous, StartHour: 1, EndHour: 2}, 12, true},
+		{SyncPolicy{Mode: SyncScheduled, StartHour: 1, EndHour: 6}, 0, false},
+		{SyncPolicy{Mode: SyncScheduled, StartHour: 1, EndHour: 6}, 1, true},
+		{SyncPolicy{Mode: SyncScheduled, StartHour: 1, EndHour: 6}, 5, true},
+		{SyncPolicy{Mode: SyncScheduled, StartHour: 1, EndHour: 6}, 6, false},
+		{SyncPolicy{Mode: SyncScheduled, StartHour: 22, EndHour: 4}, 23, true},
+		{SyncPolicy{Mode: SyncScheduled, StartHour: 22, EndHour: 4}, 3, true},
+		{SyncPolicy{Mode: SyncScheduled, StartHour: 22, EndHour: 4}, 12, false},
+	}
+
+	for _, test := range tests {
+		if got := test.policy.inWindow(at(test.hour)); got != test.want {
+			t.Errorf("%+v at %d:30: got %v, want %v", test.policy, test.hour, got, test.want)
+		}
+	}
+}
+
+func TestSyncPolicyErr(t *testing.T) {
+	now := time.Date(2022, 8, 1, 12, 0, 0, 0, time.Local)
+
+	if err := (SyncPolicy{Mode: SyncOnce}).err(now); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := (SyncPolicy{Metered: true}).err(now); err != ErrMeteredConnection {
+		t.Errorf("got %v, want %v", err, ErrMeteredConnection)
+	}
+	if err := (SyncPolicy{Mode: SyncScheduled, StartHour: 1, EndHour: 6}).err(now); err != ErrOutsideSyncHours {
+		t.Errorf("got %v, want %v", err, ErrOutsideSyncHours)
+	}
+	if err := (SyncPolicy{Mode: SyncScheduled, StartHour: 6, EndHour: 6}).validate(); err == nil {
+		t.Error("expected an error for an empty sync window")
+	}
+}
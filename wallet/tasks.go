@@ -0,0 +1,340 @@
+package wallet
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/planetdecred/dcrlibwallet"
+)
+
+const (
+	// maxEndedTasks is the number of ended tasks kept to show their
+	// result.
+	maxEndedTasks = 20
+
+	// endedTaskLifetime is how long an ended task is kept.
+	endedTaskLifetime = 10 * time.Minute
+)
+
+// ErrTaskNotCancelable is returned when a task that has ended or cannot be
+// canceled is canceled.
+var ErrTaskNotCancelable = errors.New("the task cannot be canceled")
+
+// TaskKind is the operation a task runs.
+type TaskKind int
+
+const (
+	TaskSync TaskKind = iota
+	TaskRescan
+	TaskAccountMixer
+	TaskTicketBuyer
+	TaskPoliteiaSync
+	TaskDexStart
+	TaskExchangeRate
+	TaskBroadcast
+)
+
+// TaskState is whether a task is running or how it ended.
+type TaskState int
+
+const (
+	TaskRunning TaskState = iota
+	TaskDone
+	TaskCanceled
+	TaskFailed
+)
+
+// TaskInfo is the state of a background task.
+type TaskInfo struct {
+	ID   int
+	Kind TaskKind
+
+	// WalletID is the wallet the task runs for. It is zero for tasks of
+	// all wallets.
+	WalletID int
+
+	State   TaskState
+	Started time.Time
+	Ended   time.Time
+
+	// Progress is the percentage done, or -1 if the task does not report
+	// progress.
+	Progress int
+
+	// Error is the error that ended a failed task.
+	Error string
+
+	// Cancelable is true if the task is running and can be canceled.
+	Cancelable bool
+}
+
+// Task is a handle to a registered task that reports its progress and end.
+type Task struct {
+	reg *taskRegistry
+	id  int
+}
+
+type task struct {
+	info   TaskInfo
+	cancel func()
+
+	// running reports whether a task that does not notify its end is
+	// still running.
+	running func() bool
+}
+
+// taskRegistry keeps the background tasks of the app, so the user can see
+// what runs and cancel it.
+type taskRegistry struct {
+	mu     sync.Mutex
+	nextID int
+	tasks  map[int]*task
+}
+
+// StartTask registers a running task. cancel may be nil if the task cannot be
+// canceled.
+func (wal *Wallet) StartTask(kind TaskKind, walletID int, cancel func()) *Task {
+	return wal.tasks.start(kind, walletID, cancel, nil)
+}
+
+// Tasks returns the running tasks and the recently ended ones, the most
+// recently started first.
+func (wal *Wallet) Tasks() []TaskInfo {
+	return wal.tasks.list()
+}
+
+// RunningTasks returns the number of running tasks.
+func (wal *Wallet) RunningTasks() int {
+	n := 0
+	for _, info := range wal.tasks.list() {
+		if info.State == TaskRunning {
+			n++
+		}
+	}
+	return n
+}
+
+// CancelTask cancels the running task with id.
+func (wal *Wallet) CancelTask(id int) error {
+	return wal.tasks.cancel(id)
+}
+
+// ClearEndedTasks removes the ended tasks.
+func (wal *Wallet) ClearEndedTasks() {
+	reg := &wal.tasks
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	for id, t := range reg.tasks {
+		if t.info.State != TaskRunning {
+			delete(reg.tasks, id)
+		}
+	}
+}
+
+func (reg *taskRegistry) start(kind TaskKind, walletID int, cancel func(), running func() bool) *Task {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	if reg.tasks == nil {
+		reg.tasks = make(map[int]*task)
+	}
+
+	reg.nextID++
+	reg.tasks[reg.nextID] = &task{
+		info: TaskInfo{
+			ID:       reg.nextID,
+			Kind:     kind,
+			WalletID: walletID,
+			State:    TaskRunning,
+			Started:  time.Now(),
+			Progress: -1,
+		},
+		cancel:  cancel,
+		running: running,
+	}
+	return &Task{reg: reg, id: reg.nextID}
+}
+
+// Progress sets the percentage of the task that is done.
+func (t *Task) Progress(progress int) {
+	t.reg.mu.Lock()
+	defer t.reg.mu.Unlock()
+	if task, ok := t.reg.tasks[t.id]; ok {
+		task.info.Progress = progress
+	}
+}
+
+// End marks the task as done, or failed if err is not nil.
+func (t *Task) End(err error) {
+	state := TaskDone
+	if err != nil {
+		state = TaskFailed
+	}
+	t.reg.end(t.id, state, err)
+}
+
+// Canceled marks the task as canceled.
+func (t *Task) Canceled() {
+	t.reg.end(t.id, TaskCanceled, nil)
+}
+
+func (reg *taskRegistry) end(id int, state TaskState, err error) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	task, ok := reg.tasks[id]
+	if !ok || task.info.State != TaskRunning {
+		return
+	}
+
+	task.info.State = state
+	task.info.Ended = time.Now()
+	if err != nil {
+		task.info.Error = err.Error()
+	}
+	task.cancel = nil
+	reg.prune()
+}
+
+// prune removes the ended tasks that are too old or too many. reg.mu must be
+// held.
+func (reg *taskRegistry) prune() {
+	var ended []*task
+	for id, t := range reg.tasks {
+		if t.info.State == TaskRunning {
+			continue
+		}
+		if time.Since(t.info.Ended) > endedTaskLifetime {
+			delete(reg.tasks, id)
+			continue
+		}
+		ended = append(ended, t)
+	}
+
+	if len(ended) > maxEndedTasks {
+		sort.Slice(ended, func(i, j int) bool { return ended[i].info.Ended.After(ended[j].info.Ended) })
+		for _, t := range ended[maxEndedTasks:] {
+			delete(reg.tasks, t.info.ID)
+		}
+	}
+}
+
+func (reg *taskRegistry) list() []TaskInfo {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	now := time.Now()
+	for _, t := range reg.tasks {
+		if t.info.State == TaskRunning && t.running != nil && !t.running() {
+			t.info.State = TaskDone
+			t.info.Ended = now
+			t.cancel = nil
+		}
+	}
+	reg.prune()
+
+	infos := make([]TaskInfo, 0, len(reg.tasks))
+	for _, t := range reg.tasks {
+		info := t.info
+		info.Cancelable = t.info.State == TaskRunning && t.cancel != nil
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID > infos[j].ID })
+	return infos
+}
+
+func (reg *taskRegistry) cancel(id int) error {
+	reg.mu.Lock()
+	task, ok := reg.tasks[id]
+	if !ok || task.info.State != TaskRunning || task.cancel == nil {
+		reg.mu.Unlock()
+		return ErrTaskNotCancelable
+	}
+	cancel := task.cancel
+	reg.mu.Unlock()
+
+	// The task ends when it reports it was canceled.
+	cancel()
+	return nil
+}
+
+// mixerTasks registers a task for each running account mixer. It satisfies
+// the dcrlibwallet.AccountMixerNotificationListener interface.
+type mixerTasks struct {
+	wal *Wallet
+
+	mu    sync.Mutex
+	tasks map[int]*Task
+}
+
+func (mt *mixerTasks) reset(wal *Wallet) {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	mt.wal = wal
+	mt.tasks = make(map[int]*Task)
+}
+
+func (mt *mixerTasks) OnAccountMixerStarted(walletID int) {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	if task, ok := mt.tasks[walletID]; ok {
+		task.Canceled()
+	}
+
+	multi := mt.wal.multi
+	mt.tasks[walletID] = mt.wal.StartTask(TaskAccountMixer, walletID, func() {
+		if err := multi.StopAccountMixer(walletID); err != nil {
+			log.Errorf("Unable to stop the account mixer of wallet %d: %v", walletID, err)
+		}
+	})
+}
+
+func (mt *mixerTasks) OnAccountMixerEnded(walletID int) {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	if task, ok := mt.tasks[walletID]; ok {
+		task.End(nil)
+		delete(mt.tasks, walletID)
+	}
+}
+
+// StartTicketBuyer starts the ticket buyer of w, unless it is turned off by
+// offline or Tor only mode, and registers it as a task that runs until the
+// ticket buyer stops.
+func (wal *Wallet) StartTicketBuyer(w *dcrlibwallet.Wallet, passphrase []byte) error {
+	if err := wal.FeatureError(FeatureTicketBuyer); err != nil {
+		return err
+	}
+	if err := w.StartTicketBuyer(passphrase); err != nil {
+		return err
+	}
+
+	cancel := func() {
+		if err := wal.multi.StopAutoTicketsPurchase(w.ID); err != nil {
+			log.Errorf("Unable to stop the ticket buyer of wallet %d: %v", w.ID, err)
+		}
+	}
+	wal.tasks.start(TaskTicketBuyer, w.ID, cancel, w.IsAutoTicketsPurchaseActive)
+	return nil
+}
+
+// SyncPoliteia fetches the proposal updates from politeia. It blocks until
+// the proposals are synced or the sync is stopped.
+func (wal *Wallet) SyncPoliteia() error {
+	politeia := wal.multi.Politeia
+	if politeia.IsSyncing() {
+		return nil
+	}
+
+	task := wal.StartTask(TaskPoliteiaSync, 0, politeia.StopSync)
+	err := politeia.Sync()
+	switch {
+	case err != nil && err.Error() == dcrlibwallet.ErrContextCanceled:
+		task.Canceled()
+	case err != nil && err.Error() == dcrlibwallet.ErrSyncAlreadyInProgress:
+		task.End(nil)
+	default:
+		task.End(err)
+	}
+	return err
+}
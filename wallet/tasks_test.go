@@ -0,0 +1,58 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTaskRegistry(t *testing.T) {
+	var reg taskRegistry
+
+	canceled := false
+	syncTask := reg.start(TaskSync, 0, func() { canceled = true }, nil)
+	syncTask.Progress(40)
+	rescan := reg.start(TaskRescan, 1, nil, nil)
+
+	tasks := reg.list()
+	if len(tasks) != 2 || tasks[0].Kind != TaskRescan || tasks[1].Kind != TaskSync {
+		t.Fatalf("unexpected tasks %+v", tasks)
+	}
+	if tasks[1].Progress != 40 || !tasks[1].Cancelable || tasks[0].Cancelable {
+		t.Errorf("unexpected task state %+v", tasks)
+	}
+
+	if err := reg.cancel(tasks[1].ID); err != nil || !canceled {
+		t.Errorf("unable to cancel the sync task: %v", err)
+	}
+	if err := reg.cancel(tasks[0].ID); err != ErrTaskNotCancelable {
+		t.Errorf("got %v, want %v", err, ErrTaskNotCancelable)
+	}
+
+	syncTask.Canceled()
+	rescan.End(errors.New("rescan error"))
+	rescan.End(nil)
+
+	for _, task := range reg.list() {
+		switch {
+		case task.Kind == TaskSync && task.State != TaskCanceled:
+			t.Errorf("sync task state is %v, want canceled", task.State)
+		case task.Kind == TaskRescan && (task.State != TaskFailed || task.Error != "rescan error"):
+			t.Errorf("rescan task is %+v, want failed", task)
+		}
+	}
+}
+
+func TestTaskRegistryRunningProbe(t *testing.T) {
+	var reg taskRegistry
+
+	running := true
+	reg.start(TaskTicketBuyer, 1, nil, func() bool { return running })
+	if state := reg.list()[0].State; state != TaskRunning {
+		t.Fatalf("state is %v, want running", state)
+	}
+
+	running = false
+	if state := reg.list()[0].State; state != TaskDone {
+		t.Errorf("state is %v, want done", state)
+	}
+}
@@ -3,9 +3,11 @@
 package wallet
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/planetdecred/dcrlibwallet"
@@ -25,9 +27,67 @@ type Wallet struct {
 	version     string
 	logFile     string
 	startUpTime time.Time
+
+	// configFile is the app config file, copied redacted to diagnostics
+	// bundles.
+	configFile string
+
+	// homeDir and defaultNet are the app data directory and network of the
+	// default profile. Root and Net are those of the selected profile.
+	homeDir     string
+	defaultNet  string
+	profileName string
+
+	// appLock is the lock of homeDir held by the app, and profileLock is
+	// the lock of the data directory of the selected profile if it is not
+	// homeDir.
+	appLock     *InstanceLock
+	profileLock *InstanceLock
+
+	// proxyOverride is the proxy set in the app config, if any.
+	proxyOverride *ProxyConfig
+
+	// httpMu protects nextRequest, the earliest time of the next HTTP
+	// request to each host, and client, the HTTP client for the proxy in
+	// use. client is made on the first request after the proxy is set.
+	httpMu      sync.Mutex
+	nextRequest map[string]time.Time
+	client      *http.Client
+
+	// dexLoggedOut is 1 after offline mode logged the DEX client out. The
+	// client must log in again before it is used.
+	dexLoggedOut uint32
+
+	// crashState is what the crash handler reads in place of the values
+	// that need locks.
+	crashState crashState
+
+	// syncMonitor restarts failed syncs and keeps their history.
+	syncMonitor syncMonitor
+
+	// rescanQueue runs the queued rescans.
+	rescanQueue rescanQueue
+
+	// tasks are the background tasks shown to the user. mixerTasks
+	// registers the account mixer runs as tasks.
+	tasks      taskRegistry
+	mixerTasks mixerTasks
+
+	// uncleanSession is the previous run of the app if it did not shut
+	// down cleanly.
+	uncleanSession *Session
+
+	// paymentRequestsMu protects paymentRequests, the payment URIs the app
+	// was launched with that have not been opened.
+	paymentRequestsMu sync.Mutex
+	paymentRequests   []*PaymentRequest
+
+	// securityMu protects the passphrase attempts and security audit log
+	// config values.
+	securityMu sync.Mutex
 }
 
-// NewWallet initializies an new Wallet instance.
+// NewWallet initializies an new Wallet instance using the default profile.
 // The Wallet is not loaded until LoadWallets is called.
 func NewWallet(root, net, version, logFile string, buildDate time.Time) (*Wallet, error) {
 	if root == "" || net == "" { // This should really be handled by dcrlibwallet
@@ -41,7 +101,11 @@ func NewWallet(root, net, version, logFile string, buildDate time.Time) (*Wallet
 		version:     version,
 		logFile:     logFile,
 		startUpTime: time.Now(),
+		homeDir:     root,
+		defaultNet:  net,
+		profileName: DefaultProfileName,
 	}
+	wal.cacheCrashConfig()
 
 	return wal, nil
 }
@@ -62,61 +126,103 @@ func (wal *Wallet) StartupTime() time.Time {
 	return wal.startUpTime
 }
 
+// SetAppLock sets the lock of the app data directory held by the app. The
+// data directory of a profile is locked with it when the profile is loaded.
+func (wal *Wallet) SetAppLock(lock *InstanceLock) {
+	wal.appLock = lock
+}
+
 func (wal *Wallet) InitMultiWallet() error {
-	politeiaHost := dcrlibwallet.PoliteiaMainnetHost
-	if wal.Net == dcrlibwallet.Testnet3 {
-		politeiaHost = dcrlibwallet.PoliteiaTestnetHost
+	if err := wal.lockProfileData(); err != nil {
+		return err
 	}
-	multiWal, err := dcrlibwallet.NewMultiWallet(wal.Root, "bdb", wal.Net, politeiaHost)
+
+	multiWal, err := dcrlibwallet.NewMultiWallet(wal.Root, "bdb", wal.Net, wal.network().politeiaHost)
 	if err != nil {
-		return err
+		return fmt.Errorf("unable to load %s wallets: %v", wal.Net, err)
 	}
 
 	wal.multi = multiWal
-	return nil
-}
+	wal.resetHTTPClient()
 
-func (wal *Wallet) hdPrefix() string {
-	switch wal.Net {
-	case dcrlibwallet.Testnet3:
-		return dcrlibwallet.TestnetHDPath
-	case "mainnet":
-		return dcrlibwallet.MainnetHDPath
-	default:
-		return ""
+	wal.syncMonitor.reset(wal)
+	if err = multiWal.AddSyncProgressListener(&wal.syncMonitor, syncID); err != nil {
+		return fmt.Errorf("unable to monitor the sync: %v", err)
 	}
+	wal.syncMonitor.startScheduler()
+
+	wal.rescanQueue.reset(wal)
+	multiWal.SetBlocksRescanProgressListener(&wal.rescanQueue)
+
+	wal.mixerTasks.reset(wal)
+	if err = multiWal.AddAccountMixerNotificationListener(&wal.mixerTasks, syncID); err != nil {
+		return fmt.Errorf("unable to monitor the account mixer: %v", err)
+	}
+
+	setFlag(&wal.crashState.syncing, false)
+	setFlag(&wal.crashState.synced, false)
+	setFlag(&wal.crashState.rescanning, false)
+	wal.cacheCrashConfig()
+	return nil
 }
 
 // Shutdown shutsdown the multiwallet
 func (wal *Wallet) Shutdown() {
+	wal.syncMonitor.mu.Lock()
+	wal.syncMonitor.stopScheduler()
+	wal.syncMonitor.mu.Unlock()
+
 	if wal.multi != nil {
 		wal.multi.Shutdown()
 	}
+
+	if wal.profileLock != nil {
+		if err := wal.profileLock.Release(); err != nil {
+			log.Errorf("Unable to release the profile data lock: %v", err)
+		}
+		wal.profileLock = nil
+	}
+}
+
+// lockProfileData locks the data directory of the selected profile, so an
+// instance of the app started with that directory as its app data directory
+// cannot open the same wallets. The default profile uses the app data
+// directory, which the app locked at startup.
+func (wal *Wallet) lockProfileData() error {
+	if wal.appLock == nil || wal.profileLock != nil || wal.Root == wal.homeDir {
+		return nil
+	}
+
+	lock, err := wal.appLock.LockDir(wal.Root)
+	if err != nil {
+		return fmt.Errorf("unable to lock the data directory of the %s profile: %v", wal.profileName, err)
+	}
+	wal.profileLock = lock
+	return nil
 }
 
 // GetBlockExplorerURL accept transaction hash,
 // return the block explorer URL with respect to the network
 func (wal *Wallet) GetBlockExplorerURL(txnHash string) string {
-	switch wal.Net {
-	case dcrlibwallet.Testnet3:
-		return "https://testnet.dcrdata.org/tx/" + txnHash
-	case "mainnet":
-		return "https://explorer.dcrdata.org/tx/" + txnHash
-	default:
-		return ""
-	}
+	return wal.BlockExplorer().TxLink(txnHash)
 }
 
 //GetUSDExchangeValues gets the exchange rate of DCR - USDT from a specified endpoint
 func (wal *Wallet) GetUSDExchangeValues(target interface{}) error {
-	url := "https://api.bittrex.com/v3/markets/DCR-USDT/ticker"
-	resp, err := http.Get(url)
-	if err != nil {
+	if err := wal.FeatureError(FeatureExchangeRate); err != nil {
 		return err
 	}
 
-	defer resp.Body.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	task := wal.StartTask(TaskExchangeRate, 0, cancel)
 
-	json.NewDecoder(resp.Body).Decode(target)
-	return nil
+	url := "https://api.bittrex.com/v3/markets/DCR-USDT/ticker"
+	err := wal.HTTPGet(ctx, url, target)
+	if errors.Is(err, context.Canceled) {
+		task.Canceled()
+	} else {
+		task.End(err)
+	}
+	return err
 }